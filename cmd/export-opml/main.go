@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rebelice/mention-monitor/internal/collector"
+	"github.com/rebelice/mention-monitor/internal/env"
+	"github.com/rebelice/mention-monitor/internal/opml"
+)
+
+func main() {
+	if len(os.Args) > 2 {
+		fmt.Fprintln(os.Stderr, "Usage: export-opml [file.opml]")
+		os.Exit(2)
+	}
+
+	feeds, err := collector.LoadFeeds(env.FeedsFile())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading feeds: %v\n", err)
+		os.Exit(1)
+	}
+
+	var subs []opml.Subscription
+	for _, feed := range feeds {
+		subs = append(subs, opml.Subscription{
+			Title:      feed.Title,
+			XMLURL:     feed.URL,
+			HTMLURL:    feed.SiteURL,
+			Categories: feed.Tags,
+		})
+	}
+
+	// Feeds configured through the environment, each source in its own folder
+	for _, channel := range env.SplitList(os.Getenv("YOUTUBE_CHANNELS")) {
+		subs = append(subs, opml.Subscription{XMLURL: collector.YouTubeFeedURL(channel), Categories: []string{"YouTube"}})
+	}
+	for _, feedURL := range env.SplitList(os.Getenv("PODCAST_FEEDS")) {
+		subs = append(subs, opml.Subscription{XMLURL: feedURL, Categories: []string{"Podcasts"}})
+	}
+	for _, pub := range env.SplitList(os.Getenv("SUBSTACK_PUBLICATIONS")) {
+		subs = append(subs, opml.Subscription{Title: pub, XMLURL: collector.SubstackFeedURL(pub), Categories: []string{"Substack"}})
+	}
+	for _, alertURL := range env.SplitList(os.Getenv("GOOGLE_ALERT_URLS")) {
+		subs = append(subs, opml.Subscription{XMLURL: alertURL, Categories: []string{"Google Alerts"}})
+	}
+	// Only public-inbox archives are feeds; mbox archives are skipped
+	for _, entry := range env.SplitList(os.Getenv("MAIL_ARCHIVES")) {
+		parts := strings.Split(entry, "|")
+		if len(parts) < 2 {
+			continue
+		}
+		archive := collector.MailArchive{Name: parts[0], URL: parts[1]}
+		if archive.PublicInbox() {
+			subs = append(subs, opml.Subscription{Title: archive.Name, XMLURL: archive.URL, Categories: []string{"Mailing lists"}})
+		}
+	}
+
+	// Write to stdout unless an output file is given
+	var w io.Writer = os.Stdout
+	if len(os.Args) == 2 {
+		f, err := os.Create(os.Args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating OPML file: %v\n", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := opml.New("mention-monitor feeds", subs).Write(w); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing OPML: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rebelice/mention-monitor/internal/collector"
+	"github.com/rebelice/mention-monitor/internal/env"
+	"github.com/rebelice/mention-monitor/internal/opml"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: import-opml <file.opml>")
+		os.Exit(2)
+	}
+
+	feedsFile := env.FeedsFile()
+
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening OPML file: %v\n", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	doc, err := opml.Parse(f)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing OPML file: %v\n", err)
+		os.Exit(1)
+	}
+
+	feeds, err := collector.LoadFeeds(feedsFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading feeds: %v\n", err)
+		os.Exit(1)
+	}
+
+	existing := make(map[string]bool)
+	for _, feed := range feeds {
+		existing[feed.URL] = true
+	}
+
+	added := 0
+	for _, sub := range doc.Subscriptions() {
+		if existing[sub.XMLURL] {
+			continue
+		}
+		existing[sub.XMLURL] = true
+
+		// Imported blogs are not about our keywords, so only keep matching items
+		feeds = append(feeds, collector.FeedConfig{
+			URL:     sub.XMLURL,
+			Title:   sub.Title,
+			SiteURL: sub.HTMLURL,
+			Filter:  true,
+			Tags:    sub.Categories,
+		})
+		added++
+	}
+
+	if err := collector.SaveFeeds(feedsFile, feeds); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving feeds: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Imported %d new feeds into %s (%d total)\n", added, feedsFile, len(feeds))
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/rebelice/mention-monitor/internal/collector"
+	"github.com/rebelice/mention-monitor/internal/env"
 	"github.com/rebelice/mention-monitor/internal/models"
 	"github.com/rebelice/mention-monitor/internal/notifier"
 )
@@ -19,36 +21,75 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
+	startedAt := time.Now().UTC()
+
 	// Load configuration from environment
 	config := loadConfig()
 
 	fmt.Println("Starting mention monitor...")
 	fmt.Printf("Keywords: %v\n", config.Keywords)
 
+	// Load existing data
+	data := loadData()
+	seen := make(map[string]bool)
+	for _, m := range data.Mentions {
+		seen[m.ID] = true
+	}
+	if data.State == nil {
+		data.State = make(map[string]string)
+	}
+
+	fmt.Printf("Loaded %d existing mentions\n", len(data.Mentions))
+
 	// Initialize collectors
 	coll := collector.New(
-		&collector.HackerNews{},
-		&collector.Reddit{},
+		&collector.HackerNews{FetchParent: config.HNFetchParent},
+		&collector.Reddit{
+			ClientID:          config.RedditClientID,
+			ClientSecret:      config.RedditClientSecret,
+			Username:          config.RedditUsername,
+			Password:          config.RedditPassword,
+			Subreddits:        config.RedditSubreddits,
+			ExcludeSubreddits: config.RedditExcludeSubreddits,
+		},
+		&collector.Lemmy{Instances: config.LemmyInstances},
+		&collector.Discourse{Forums: config.DiscourseForums},
 		&collector.GitHub{Token: config.GitHubToken},
+		&collector.GitLab{Instances: config.GitLabInstances},
+		&collector.Gitea{Instances: config.GiteaInstances},
+		&collector.Bitbucket{
+			Username:     config.BitbucketUsername,
+			AppPassword:  config.BitbucketAppPassword,
+			Workspaces:   config.BitbucketWorkspaces,
+			Repositories: config.BitbucketRepos,
+		},
 		&collector.Twitter{NitterInstances: collector.DefaultNitterInstances},
 		&collector.DevTo{},
 		&collector.Medium{},
+		&collector.Hashnode{Publications: config.HashnodePublications},
+		&collector.Substack{Publications: config.SubstackPublications},
+		&collector.Ghost{Sites: config.GhostSites},
 		&collector.StackOverflow{},
 		&collector.ProductHunt{},
 		&collector.Lobsters{},
+		&collector.V2EX{},
+		&collector.Juejin{},
+		&collector.SegmentFault{},
 		&collector.PkgGoDev{},
+		&collector.Npm{Packages: config.NpmPackages, State: data.State},
+		&collector.PyPI{Packages: config.PyPIPackages, State: data.State},
+		&collector.CratesIO{Crates: config.Crates, State: data.State},
 		&collector.Google{AlertRSSURLs: config.GoogleAlertURLs},
+		&collector.Feed{Feeds: config.Feeds},
+		&collector.PageWatch{Pages: config.WatchedPages, State: data.State},
+		&collector.AwesomeList{Lists: config.AwesomeLists, Discover: config.AwesomeDiscover, Token: config.GitHubToken, State: data.State},
+		&collector.YouTube{Channels: config.YouTubeChannels},
+		&collector.Podcast{Feeds: config.PodcastFeeds},
+		&collector.MailingList{Archives: config.MailArchives},
+		&collector.Telegram{BotToken: config.TelegramSourceBotToken, Chats: config.TelegramSourceChats, State: data.State},
+		&collector.Discord{BotToken: config.DiscordBotToken, ChannelIDs: config.DiscordChannelIDs, State: data.State},
 	)
 
-	// Load existing data
-	data := loadData()
-	seen := make(map[string]bool)
-	for _, m := range data.Mentions {
-		seen[m.ID] = true
-	}
-
-	fmt.Printf("Loaded %d existing mentions\n", len(data.Mentions))
-
 	// Collect new mentions
 	fmt.Println("Collecting mentions from all sources...")
 	allMentions := coll.CollectAll(ctx, config.Keywords)
@@ -82,23 +123,25 @@ func main() {
 				}
 			}
 		}
+	}
 
-		// Send Bark notification
-		if config.BarkDeviceKey != "" {
-			fmt.Println("Sending Bark notifications...")
-			var bark *notifier.Bark
-			if config.BarkServerURL != "" {
-				bark = notifier.NewBarkWithServer(config.BarkServerURL, config.BarkDeviceKey)
-			} else {
-				bark = notifier.NewBark(config.BarkDeviceKey)
-			}
+	// Notify configured destinations, immediately or as scheduled digests
+	run := notifier.WebhookRun{
+		ID:        runID(startedAt),
+		StartedAt: startedAt,
+		Keywords:  config.Keywords,
+		Collected: len(allMentions),
+	}
+	notifiers, unavailable, closeNotifiers := configureNotifiers(ctx, config, run)
+	defer closeNotifiers()
+	deliverMentions(ctx, &data, notifiers, unavailable, newMentions, time.Now().UTC())
 
-			// Send individual notifications for detailed mode
-			if err := bark.Send(ctx, newMentions); err != nil {
-				fmt.Printf("Bark error: %v\n", err)
-			} else {
-				fmt.Printf("Sent %d Bark notifications\n", len(newMentions))
-			}
+	// Apply triage buttons pressed in Telegram since the last run. This is
+	// opt-in: it reads the same bot updates as cmd/telegram-triage, and
+	// Telegram allows only one getUpdates consumer per bot.
+	if config.TelegramTriage && config.TelegramBotToken != "" && config.DatabaseURL != "" {
+		if err := applyTelegramTriage(ctx, config); err != nil {
+			fmt.Printf("Telegram triage error: %v\n", err)
 		}
 	}
 
@@ -109,12 +152,87 @@ func main() {
 }
 
 type Config struct {
-	Keywords        []string
-	GitHubToken     string
-	GoogleAlertURLs []string
-	DatabaseURL     string
-	BarkDeviceKey   string
-	BarkServerURL   string
+	Keywords                []string
+	GitHubToken             string
+	HNFetchParent           bool
+	RedditClientID          string
+	RedditClientSecret      string
+	RedditUsername          string
+	RedditPassword          string
+	RedditSubreddits        []string
+	RedditExcludeSubreddits []string
+	GoogleAlertURLs         []string
+	NpmPackages             []string
+	PyPIPackages            []string
+	Crates                  []string
+	Feeds                   []collector.FeedConfig
+	LemmyInstances          []string
+	DiscourseForums         []string
+	YouTubeChannels         []string
+	PodcastFeeds            []string
+	HashnodePublications    []string
+	SubstackPublications    []string
+	GhostSites              []collector.GhostSite
+	GitLabInstances         []collector.ForgeInstance
+	GiteaInstances          []collector.ForgeInstance
+	BitbucketUsername       string
+	BitbucketAppPassword    string
+	BitbucketWorkspaces     []string
+	BitbucketRepos          []string
+	MailArchives            []collector.MailArchive
+	TelegramSourceBotToken  string
+	TelegramSourceChats     []string
+	DiscordBotToken         string
+	DiscordChannelIDs       []string
+	WatchedPages            []collector.WatchedPage
+	AwesomeLists            []string
+	AwesomeDiscover         bool
+	DatabaseURL             string
+	BarkDeviceKeys          []string
+	BarkServerURL           string
+	BarkLevel               string
+	BarkSound               string
+	BarkGroupBy             string
+	BarkBadge               bool
+	BarkEncryptionKey       string
+	BarkEncryptionIV        string
+	NtfyServerURL           string
+	NtfyTopic               string
+	NtfyToken               string
+	NtfyPriority            int
+	GotifyServerURL         string
+	GotifyAppToken          string
+	GotifyPriority          int
+	PushoverAppToken        string
+	PushoverUserKey         string
+	PushoverPriority        int
+	SlackWebhookURL         string
+	SlackBotToken           string
+	SlackChannel            string
+	DiscordWebhookURLs      []string
+	MatrixHomeserverURL     string
+	MatrixAccessToken       string
+	MatrixRoomIDs           []string
+	FeishuWebhookURL        string
+	FeishuSecret            string
+	DingTalkWebhookURL      string
+	DingTalkSecret          string
+	WeComWebhookURL         string
+	WebhookURLs             []string
+	WebhookSecret           string
+	DeliveryModes           map[string]string
+	TelegramBotToken        string
+	TelegramChatID          string
+	TelegramTriage          bool
+	SMTPHost                string
+	SMTPPort                int
+	SMTPUsername            string
+	SMTPPassword            string
+	SMTPTLS                 string
+	EmailFrom               string
+	EmailTo                 []string
+	EmailHTMLTemplate       string
+	EmailTextTemplate       string
 }
 
 func loadConfig() Config {
@@ -129,14 +247,430 @@ func loadConfig() Config {
 		alertURLs = strings.Split(googleAlerts, ",")
 	}
 
+	feeds, err := collector.LoadFeeds(env.FeedsFile())
+	if err != nil {
+		fmt.Printf("Error loading feeds: %v\n", err)
+	}
+
 	return Config{
-		Keywords:        strings.Split(keywords, ","),
-		GitHubToken:     os.Getenv("GITHUB_TOKEN"),
-		GoogleAlertURLs: alertURLs,
-		DatabaseURL:     os.Getenv("DATABASE_URL"),
-		BarkDeviceKey:   os.Getenv("BARK_DEVICE_KEY"),
-		BarkServerURL:   os.Getenv("BARK_SERVER_URL"),
+		Keywords:                strings.Split(keywords, ","),
+		GitHubToken:             os.Getenv("GITHUB_TOKEN"),
+		GoogleAlertURLs:         alertURLs,
+		HNFetchParent:           os.Getenv("HN_FETCH_PARENT") == "true",
+		RedditClientID:          os.Getenv("REDDIT_CLIENT_ID"),
+		RedditClientSecret:      os.Getenv("REDDIT_CLIENT_SECRET"),
+		RedditUsername:          os.Getenv("REDDIT_USERNAME"),
+		RedditPassword:          os.Getenv("REDDIT_PASSWORD"),
+		RedditSubreddits:        env.SplitList(os.Getenv("REDDIT_SUBREDDITS")),
+		RedditExcludeSubreddits: env.SplitList(os.Getenv("REDDIT_EXCLUDE_SUBREDDITS")),
+		NpmPackages:             env.SplitList(os.Getenv("NPM_PACKAGES")),
+		PyPIPackages:            env.SplitList(os.Getenv("PYPI_PACKAGES")),
+		Crates:                  env.SplitList(os.Getenv("CRATES")),
+		Feeds:                   feeds,
+		LemmyInstances:          env.SplitList(os.Getenv("LEMMY_INSTANCES")),
+		DiscourseForums:         env.SplitList(os.Getenv("DISCOURSE_FORUMS")),
+		YouTubeChannels:         env.SplitList(os.Getenv("YOUTUBE_CHANNELS")),
+		PodcastFeeds:            env.SplitList(os.Getenv("PODCAST_FEEDS")),
+		HashnodePublications:    env.SplitList(os.Getenv("HASHNODE_PUBLICATIONS")),
+		SubstackPublications:    env.SplitList(os.Getenv("SUBSTACK_PUBLICATIONS")),
+		GhostSites:              parseGhostSites(os.Getenv("GHOST_SITES")),
+		GitLabInstances:         parseForgeInstances(os.Getenv("GITLAB_INSTANCES"), "https://gitlab.com", os.Getenv("GITLAB_TOKEN")),
+		GiteaInstances:          parseForgeInstances(os.Getenv("GITEA_INSTANCES"), "https://codeberg.org", os.Getenv("GITEA_TOKEN")),
+		BitbucketUsername:       os.Getenv("BITBUCKET_USERNAME"),
+		BitbucketAppPassword:    os.Getenv("BITBUCKET_APP_PASSWORD"),
+		BitbucketWorkspaces:     env.SplitList(os.Getenv("BITBUCKET_WORKSPACES")),
+		BitbucketRepos:          env.SplitList(os.Getenv("BITBUCKET_REPOS")),
+		MailArchives:            parseMailArchives(os.Getenv("MAIL_ARCHIVES")),
+		TelegramSourceBotToken:  os.Getenv("TELEGRAM_SOURCE_BOT_TOKEN"),
+		TelegramSourceChats:     env.SplitList(os.Getenv("TELEGRAM_SOURCE_CHATS")),
+		DiscordBotToken:         os.Getenv("DISCORD_BOT_TOKEN"),
+		DiscordChannelIDs:       env.SplitList(os.Getenv("DISCORD_CHANNEL_IDS")),
+		WatchedPages:            parseWatchedPages(os.Getenv("PAGE_WATCH")),
+		AwesomeLists:            env.SplitList(os.Getenv("AWESOME_LISTS")),
+		AwesomeDiscover:         os.Getenv("AWESOME_DISCOVER") == "true",
+		DatabaseURL:             os.Getenv("DATABASE_URL"),
+		BarkDeviceKeys:          env.SplitList(os.Getenv("BARK_DEVICE_KEY")),
+		BarkServerURL:           os.Getenv("BARK_SERVER_URL"),
+		BarkLevel:               os.Getenv("BARK_LEVEL"),
+		BarkSound:               os.Getenv("BARK_SOUND"),
+		BarkGroupBy:             os.Getenv("BARK_GROUP_BY"),
+		BarkBadge:               os.Getenv("BARK_BADGE") == "true",
+		BarkEncryptionKey:       os.Getenv("BARK_ENCRYPTION_KEY"),
+		BarkEncryptionIV:        os.Getenv("BARK_ENCRYPTION_IV"),
+		NtfyServerURL:           os.Getenv("NTFY_SERVER_URL"),
+		NtfyTopic:               os.Getenv("NTFY_TOPIC"),
+		NtfyToken:               os.Getenv("NTFY_TOKEN"),
+		NtfyPriority:            envInt("NTFY_PRIORITY"),
+		GotifyServerURL:         os.Getenv("GOTIFY_SERVER_URL"),
+		GotifyAppToken:          os.Getenv("GOTIFY_APP_TOKEN"),
+		GotifyPriority:          envInt("GOTIFY_PRIORITY"),
+		PushoverAppToken:        os.Getenv("PUSHOVER_APP_TOKEN"),
+		PushoverUserKey:         os.Getenv("PUSHOVER_USER_KEY"),
+		PushoverPriority:        envInt("PUSHOVER_PRIORITY"),
+		SlackWebhookURL:         os.Getenv("SLACK_WEBHOOK_URL"),
+		SlackBotToken:           os.Getenv("SLACK_BOT_TOKEN"),
+		SlackChannel:            os.Getenv("SLACK_CHANNEL"),
+		DiscordWebhookURLs:      env.SplitList(os.Getenv("DISCORD_WEBHOOK_URLS")),
+		MatrixHomeserverURL:     os.Getenv("MATRIX_HOMESERVER_URL"),
+		MatrixAccessToken:       os.Getenv("MATRIX_ACCESS_TOKEN"),
+		MatrixRoomIDs:           env.SplitList(os.Getenv("MATRIX_ROOM_IDS")),
+		FeishuWebhookURL:        os.Getenv("FEISHU_WEBHOOK_URL"),
+		FeishuSecret:            os.Getenv("FEISHU_SECRET"),
+		DingTalkWebhookURL:      os.Getenv("DINGTALK_WEBHOOK_URL"),
+		DingTalkSecret:          os.Getenv("DINGTALK_SECRET"),
+		WeComWebhookURL:         os.Getenv("WECOM_WEBHOOK_URL"),
+		WebhookURLs:             env.SplitList(os.Getenv("WEBHOOK_URLS")),
+		WebhookSecret:           os.Getenv("WEBHOOK_SECRET"),
+		DeliveryModes:           parseDeliveryModes(os.Environ()),
+		TelegramBotToken:        os.Getenv("TELEGRAM_BOT_TOKEN"),
+		TelegramChatID:          os.Getenv("TELEGRAM_CHAT_ID"),
+		TelegramTriage:          os.Getenv("TELEGRAM_TRIAGE") == "true",
+		SMTPHost:                os.Getenv("SMTP_HOST"),
+		SMTPPort:                envInt("SMTP_PORT"),
+		SMTPUsername:            os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:            os.Getenv("SMTP_PASSWORD"),
+		SMTPTLS:                 os.Getenv("SMTP_TLS"),
+		EmailFrom:               os.Getenv("EMAIL_FROM"),
+		EmailTo:                 env.SplitList(os.Getenv("EMAIL_TO")),
+		EmailHTMLTemplate:       os.Getenv("EMAIL_HTML_TEMPLATE"),
+		EmailTextTemplate:       os.Getenv("EMAIL_TEXT_TEMPLATE"),
+	}
+}
+
+// scheduledNotifier is a configured notifier and its delivery mode
+type scheduledNotifier struct {
+	notifier.Notifier
+	Mode notifier.DeliveryMode
+}
+
+// configureNotifiers creates the configured notifiers. Push and chat
+// notifiers default to one message per mention, digest-style ones to one
+// summary per run; <NAME>_DELIVERY overrides either. It also returns the
+// names of notifiers that are configured but failed to set up this run.
+func configureNotifiers(ctx context.Context, config Config, run notifier.WebhookRun) ([]scheduledNotifier, []string, func()) {
+	var notifiers []scheduledNotifier
+	var unavailable []string
+	add := func(n notifier.Notifier, def notifier.DeliveryMode) {
+		mode, err := notifier.ParseDeliveryMode(config.DeliveryModes[n.Name()], def)
+		if err != nil {
+			fmt.Printf("Using %s delivery for %s: %v\n", def, n.Name(), err)
+		}
+		notifiers = append(notifiers, scheduledNotifier{Notifier: n, Mode: mode})
+	}
+	closers := []func(){}
+
+	if len(config.BarkDeviceKeys) > 0 {
+		var bark *notifier.Bark
+		if config.BarkServerURL != "" {
+			bark = notifier.NewBarkWithServer(config.BarkServerURL, config.BarkDeviceKeys...)
+		} else {
+			bark = notifier.NewBark(config.BarkDeviceKeys...)
+		}
+		bark.Level = config.BarkLevel
+		bark.Sound = config.BarkSound
+		bark.GroupBy = config.BarkGroupBy
+		bark.Badge = config.BarkBadge
+		bark.EncryptionKey = config.BarkEncryptionKey
+		bark.EncryptionIV = config.BarkEncryptionIV
+		add(bark, notifier.Immediate)
+	}
+
+	if config.NtfyTopic != "" {
+		ntfy := notifier.NewNtfy(config.NtfyServerURL, config.NtfyTopic)
+		ntfy.Token = config.NtfyToken
+		ntfy.Priority = config.NtfyPriority
+		add(ntfy, notifier.Immediate)
+	}
+
+	if config.GotifyServerURL != "" && config.GotifyAppToken != "" {
+		gotify := notifier.NewGotify(config.GotifyServerURL, config.GotifyAppToken)
+		if config.GotifyPriority != 0 {
+			gotify.Priority = config.GotifyPriority
+		}
+		add(gotify, notifier.Immediate)
+	}
+
+	if config.PushoverAppToken != "" && config.PushoverUserKey != "" {
+		pushover := notifier.NewPushover(config.PushoverAppToken, config.PushoverUserKey)
+		pushover.Priority = config.PushoverPriority
+		add(pushover, notifier.Immediate)
+	}
+
+	// Slack digests are threaded when posting as a bot
+	if config.SlackBotToken != "" {
+		add(notifier.NewSlackBot(config.SlackBotToken, config.SlackChannel), notifier.Batch)
+	} else if config.SlackWebhookURL != "" {
+		add(notifier.NewSlackWebhook(config.SlackWebhookURL), notifier.Batch)
+	}
+
+	if len(config.DiscordWebhookURLs) > 0 {
+		add(notifier.NewDiscord(config.DiscordWebhookURLs...), notifier.Batch)
+	}
+
+	if config.MatrixHomeserverURL != "" && config.MatrixAccessToken != "" && len(config.MatrixRoomIDs) > 0 {
+		add(notifier.NewMatrix(config.MatrixHomeserverURL, config.MatrixAccessToken, config.MatrixRoomIDs...), notifier.Immediate)
+	}
+
+	if config.FeishuWebhookURL != "" {
+		add(notifier.NewFeishu(config.FeishuWebhookURL, config.FeishuSecret), notifier.Batch)
+	}
+	if config.DingTalkWebhookURL != "" {
+		add(notifier.NewDingTalk(config.DingTalkWebhookURL, config.DingTalkSecret), notifier.Batch)
+	}
+	if config.WeComWebhookURL != "" {
+		add(notifier.NewWeCom(config.WeComWebhookURL), notifier.Batch)
+	}
+
+	// Webhook delivery attempts are recorded in PostgreSQL when it is configured
+	if len(config.WebhookURLs) > 0 {
+		webhook := notifier.NewWebhook(config.WebhookSecret, config.WebhookURLs...)
+		webhook.Run = run
+		if config.DatabaseURL != "" {
+			pg, err := notifier.NewPostgres(ctx, config.DatabaseURL)
+			if err != nil {
+				fmt.Printf("Webhook deliveries won't be recorded: %v\n", err)
+			} else {
+				closers = append(closers, pg.Close)
+				webhook.Recorder = pg.RecordDelivery
+			}
+		}
+		add(webhook, notifier.Batch)
+	}
+
+	// Telegram messages carry per-mention triage buttons
+	if config.TelegramBotToken != "" && config.TelegramChatID != "" {
+		add(notifier.NewTelegram(config.TelegramBotToken, config.TelegramChatID), notifier.Immediate)
+	}
+
+	if config.SMTPHost != "" && len(config.EmailTo) > 0 {
+		email := notifier.NewEmail(config.SMTPHost, config.SMTPPort, config.EmailFrom, config.EmailTo)
+		email.Username = config.SMTPUsername
+		email.Password = config.SMTPPassword
+		if config.SMTPTLS != "" {
+			email.TLS = config.SMTPTLS
+		}
+		if err := email.LoadTemplates(config.EmailHTMLTemplate, config.EmailTextTemplate); err != nil {
+			fmt.Printf("Email error: %v\n", err)
+			unavailable = append(unavailable, email.Name())
+		} else {
+			add(email, notifier.Batch)
+		}
+	}
+
+	return notifiers, unavailable, func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+}
+
+// deliverMentions sends new mentions to each notifier according to its
+// delivery mode. Scheduled notifiers queue mention IDs in data until their
+// digest window closes; a failed digest stays queued for the next run.
+// Unavailable names are notifiers that are still configured but couldn't be
+// set up this run; their queues are kept for when they recover.
+func deliverMentions(ctx context.Context, data *models.Data, notifiers []scheduledNotifier, unavailable []string, newMentions []models.Mention, now time.Time) {
+	if data.Queue == nil {
+		data.Queue = make(map[string][]string)
+	}
+	if data.LastDelivered == nil {
+		data.LastDelivered = make(map[string]time.Time)
+	}
+
+	// Forget queues and windows of notifiers that are no longer configured
+	configured := make(map[string]bool, len(notifiers)+len(unavailable))
+	for _, n := range notifiers {
+		configured[n.Name()] = true
+	}
+	for _, name := range unavailable {
+		configured[name] = true
+	}
+	for name := range data.Queue {
+		if !configured[name] {
+			delete(data.Queue, name)
+		}
+	}
+	for name := range data.LastDelivered {
+		if !configured[name] {
+			delete(data.LastDelivered, name)
+		}
+	}
+
+	byID := make(map[string]models.Mention, len(data.Mentions))
+	for _, m := range data.Mentions {
+		byID[m.ID] = m
+	}
+
+	for _, n := range notifiers {
+		name := n.Name()
+
+		// Queue new mentions, including for notifiers that just left a
+		// scheduled mode so their leftover queue is flushed below
+		queue := data.Queue[name]
+		for _, m := range newMentions {
+			queue = append(queue, m.ID)
+		}
+
+		if n.Mode.Scheduled() {
+			last, ok := data.LastDelivered[name]
+			if !ok {
+				// Start the first window now rather than sending right away
+				data.LastDelivered[name] = now
+			}
+			if !ok || !n.Mode.Due(last, now) {
+				if len(newMentions) > 0 {
+					fmt.Printf("Queued %d mentions for %s %s digest (%d pending)\n", len(newMentions), n.Mode, name, len(queue))
+				}
+				data.Queue[name] = queue
+				continue
+			}
+		}
+
+		var pending []models.Mention
+		for _, id := range queue {
+			// IDs no longer in data.Mentions (e.g., removed by the archive
+			// workflow) have nothing left to send and are dropped
+			if m, ok := byID[id]; ok {
+				pending = append(pending, m)
+			}
+		}
+
+		if len(pending) > 0 {
+			fmt.Printf("Sending %d mentions to %s (%s)...\n", len(pending), name, n.Mode)
+			var err error
+			if n.Mode == notifier.Immediate {
+				err = n.Send(ctx, pending)
+			} else {
+				err = n.SendBatch(ctx, pending)
+			}
+			if err != nil {
+				fmt.Printf("%s error: %v\n", name, err)
+				if n.Mode.Scheduled() {
+					data.Queue[name] = queue
+					continue
+				}
+			} else {
+				fmt.Printf("Sent %d mentions to %s\n", len(pending), name)
+			}
+		}
+
+		delete(data.Queue, name)
+		if n.Mode.Scheduled() {
+			data.LastDelivered[name] = now
+		}
+	}
+}
+
+// runID identifies this run in webhook payloads, preferring the GitHub Actions run
+func runID(startedAt time.Time) string {
+	if id := os.Getenv("GITHUB_RUN_ID"); id != "" {
+		return id
+	}
+	return strconv.FormatInt(startedAt.Unix(), 10)
+}
+
+// applyTelegramTriage stores the statuses chosen with Telegram buttons in PostgreSQL
+func applyTelegramTriage(ctx context.Context, config Config) error {
+	pg, err := notifier.NewPostgres(ctx, config.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer pg.Close()
+
+	telegram := notifier.NewTelegram(config.TelegramBotToken, config.TelegramChatID)
+	handled, err := telegram.ProcessCallbacks(ctx, 0, func(ctx context.Context, idHash, status string) error {
+		_, err := pg.UpdateStatus(ctx, idHash, status)
+		return err
+	})
+	if handled > 0 {
+		fmt.Printf("Applied %d Telegram triage actions\n", handled)
+	}
+	return err
+}
+
+// parseDeliveryModes collects <NAME>_DELIVERY variables, keyed by lowercase
+// notifier name (e.g., BARK_DELIVERY=daily sets "bark")
+func parseDeliveryModes(environ []string) map[string]string {
+	modes := make(map[string]string)
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		if name, ok := strings.CutSuffix(key, "_DELIVERY"); ok && value != "" {
+			modes[strings.ToLower(name)] = strings.TrimSpace(value)
+		}
+	}
+	return modes
+}
+
+// envInt reads an integer environment value; unset or invalid values are zero
+func envInt(name string) int {
+	n, _ := strconv.Atoi(os.Getenv(name))
+	return n
+}
+
+// parseGhostSites parses comma-separated "url|content-api-key" pairs
+func parseGhostSites(value string) []collector.GhostSite {
+	var sites []collector.GhostSite
+	for _, entry := range env.SplitList(value) {
+		siteURL, key, ok := strings.Cut(entry, "|")
+		if !ok {
+			fmt.Printf("Ignoring Ghost site without API key: %s\n", entry)
+			continue
+		}
+		sites = append(sites, collector.GhostSite{URL: siteURL, Key: key})
+	}
+	return sites
+}
+
+// parseForgeInstances parses comma-separated "url|token" pairs (token optional),
+// falling back to the public instance with defaultToken
+func parseForgeInstances(value, defaultURL, defaultToken string) []collector.ForgeInstance {
+	entries := env.SplitList(value)
+	if len(entries) == 0 {
+		return []collector.ForgeInstance{{URL: defaultURL, Token: defaultToken}}
+	}
+
+	var instances []collector.ForgeInstance
+	for _, entry := range entries {
+		instanceURL, token, _ := strings.Cut(entry, "|")
+		instances = append(instances, collector.ForgeInstance{URL: instanceURL, Token: token})
+	}
+	return instances
+}
+
+// parseMailArchives parses comma-separated "name|url[|message-url]" entries
+func parseMailArchives(value string) []collector.MailArchive {
+	var archives []collector.MailArchive
+	for _, entry := range env.SplitList(value) {
+		parts := strings.Split(entry, "|")
+		if len(parts) < 2 {
+			fmt.Printf("Ignoring mail archive without URL: %s\n", entry)
+			continue
+		}
+		archive := collector.MailArchive{Name: parts[0], URL: parts[1]}
+		if len(parts) > 2 {
+			archive.MessageURL = parts[2]
+		}
+		archives = append(archives, archive)
+	}
+	return archives
+}
+
+// parseWatchedPages parses one "url[|css-selector]" entry per line, since
+// selectors may themselves contain commas
+func parseWatchedPages(value string) []collector.WatchedPage {
+	var pages []collector.WatchedPage
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		pageURL, selector, _ := strings.Cut(line, "|")
+		pages = append(pages, collector.WatchedPage{
+			URL:      strings.TrimSpace(pageURL),
+			Selector: strings.TrimSpace(selector),
+		})
 	}
+	return pages
 }
 
 func loadData() models.Data {
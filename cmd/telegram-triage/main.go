@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/notifier"
+)
+
+// pollTimeout is how long each getUpdates request waits for button presses
+const pollTimeout = 50 * time.Second
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	botToken := os.Getenv("TELEGRAM_BOT_TOKEN")
+	dbURL := os.Getenv("DATABASE_URL")
+	if botToken == "" || dbURL == "" {
+		fmt.Println("TELEGRAM_BOT_TOKEN and DATABASE_URL are required")
+		os.Exit(2)
+	}
+
+	pg, err := notifier.NewPostgres(ctx, dbURL)
+	if err != nil {
+		fmt.Printf("PostgreSQL connection error: %v\n", err)
+		os.Exit(1)
+	}
+	defer pg.Close()
+
+	telegram := notifier.NewTelegram(botToken, os.Getenv("TELEGRAM_CHAT_ID"))
+
+	fmt.Println("Waiting for Telegram triage actions...")
+	for ctx.Err() == nil {
+		_, err := telegram.ProcessCallbacks(ctx, pollTimeout, func(ctx context.Context, idHash, status string) error {
+			found, err := pg.UpdateStatus(ctx, idHash, status)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return fmt.Errorf("no mention with hash %s", idHash)
+			}
+			fmt.Printf("Marked %s as %s\n", idHash, status)
+			return nil
+		})
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("Telegram error: %v\n", err)
+			time.Sleep(5 * time.Second)
+		}
+	}
+}
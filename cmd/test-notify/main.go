@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
+	"github.com/rebelice/mention-monitor/internal/env"
 	"github.com/rebelice/mention-monitor/internal/models"
 	"github.com/rebelice/mention-monitor/internal/notifier"
 )
@@ -52,16 +54,22 @@ func main() {
 	}
 
 	// Test Bark
-	barkKey := os.Getenv("BARK_DEVICE_KEY")
+	barkKeys := os.Getenv("BARK_DEVICE_KEY")
 	barkServer := os.Getenv("BARK_SERVER_URL")
-	if barkKey != "" {
+	if barkKeys != "" {
 		fmt.Println("\nSending Bark notification...")
 		var bark *notifier.Bark
 		if barkServer != "" {
-			bark = notifier.NewBarkWithServer(barkServer, barkKey)
+			bark = notifier.NewBarkWithServer(barkServer, env.SplitList(barkKeys)...)
 		} else {
-			bark = notifier.NewBark(barkKey)
+			bark = notifier.NewBark(env.SplitList(barkKeys)...)
 		}
+		bark.Level = os.Getenv("BARK_LEVEL")
+		bark.Sound = os.Getenv("BARK_SOUND")
+		bark.GroupBy = os.Getenv("BARK_GROUP_BY")
+		bark.Badge = os.Getenv("BARK_BADGE") == "true"
+		bark.EncryptionKey = os.Getenv("BARK_ENCRYPTION_KEY")
+		bark.EncryptionIV = os.Getenv("BARK_ENCRYPTION_IV")
 		if err := bark.Send(ctx, mentions); err != nil {
 			fmt.Printf("Bark error: %v\n", err)
 		} else {
@@ -71,5 +79,182 @@ func main() {
 		fmt.Println("Bark: Skipped (not configured)")
 	}
 
+	// Test ntfy
+	ntfyTopic := os.Getenv("NTFY_TOPIC")
+	if ntfyTopic != "" {
+		fmt.Println("\nSending ntfy notification...")
+		ntfy := notifier.NewNtfy(os.Getenv("NTFY_SERVER_URL"), ntfyTopic)
+		ntfy.Token = os.Getenv("NTFY_TOKEN")
+		if err := ntfy.Send(ctx, mentions); err != nil {
+			fmt.Printf("ntfy error: %v\n", err)
+		} else {
+			fmt.Println("ntfy: Success!")
+		}
+	} else {
+		fmt.Println("ntfy: Skipped (not configured)")
+	}
+
+	// Test Gotify
+	gotifyServer := os.Getenv("GOTIFY_SERVER_URL")
+	gotifyToken := os.Getenv("GOTIFY_APP_TOKEN")
+	if gotifyServer != "" && gotifyToken != "" {
+		fmt.Println("\nSending Gotify notification...")
+		gotify := notifier.NewGotify(gotifyServer, gotifyToken)
+		if err := gotify.Send(ctx, mentions); err != nil {
+			fmt.Printf("Gotify error: %v\n", err)
+		} else {
+			fmt.Println("Gotify: Success!")
+		}
+	} else {
+		fmt.Println("Gotify: Skipped (not configured)")
+	}
+
+	// Test Pushover
+	pushoverToken := os.Getenv("PUSHOVER_APP_TOKEN")
+	pushoverUser := os.Getenv("PUSHOVER_USER_KEY")
+	if pushoverToken != "" && pushoverUser != "" {
+		fmt.Println("\nSending Pushover notification...")
+		pushover := notifier.NewPushover(pushoverToken, pushoverUser)
+		if err := pushover.Send(ctx, mentions); err != nil {
+			fmt.Printf("Pushover error: %v\n", err)
+		} else {
+			fmt.Println("Pushover: Success!")
+		}
+	} else {
+		fmt.Println("Pushover: Skipped (not configured)")
+	}
+
+	// Test Slack
+	slackWebhook := os.Getenv("SLACK_WEBHOOK_URL")
+	slackToken := os.Getenv("SLACK_BOT_TOKEN")
+	if slackWebhook != "" || slackToken != "" {
+		fmt.Println("\nSending Slack message...")
+		var slack *notifier.Slack
+		if slackToken != "" {
+			slack = notifier.NewSlackBot(slackToken, os.Getenv("SLACK_CHANNEL"))
+		} else {
+			slack = notifier.NewSlackWebhook(slackWebhook)
+		}
+		if err := slack.SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("Slack error: %v\n", err)
+		} else {
+			fmt.Println("Slack: Success!")
+		}
+	} else {
+		fmt.Println("Slack: Skipped (not configured)")
+	}
+
+	// Test Discord
+	discordWebhooks := os.Getenv("DISCORD_WEBHOOK_URLS")
+	if discordWebhooks != "" {
+		fmt.Println("\nSending Discord message...")
+		discord := notifier.NewDiscord(env.SplitList(discordWebhooks)...)
+		if err := discord.SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("Discord error: %v\n", err)
+		} else {
+			fmt.Println("Discord: Success!")
+		}
+	} else {
+		fmt.Println("Discord: Skipped (not configured)")
+	}
+
+	// Test Matrix
+	matrixServer := os.Getenv("MATRIX_HOMESERVER_URL")
+	matrixToken := os.Getenv("MATRIX_ACCESS_TOKEN")
+	matrixRooms := os.Getenv("MATRIX_ROOM_IDS")
+	if matrixServer != "" && matrixToken != "" && matrixRooms != "" {
+		fmt.Println("\nSending Matrix notice...")
+		matrix := notifier.NewMatrix(matrixServer, matrixToken, env.SplitList(matrixRooms)...)
+		if err := matrix.Send(ctx, mentions); err != nil {
+			fmt.Printf("Matrix error: %v\n", err)
+		} else {
+			fmt.Println("Matrix: Success!")
+		}
+	} else {
+		fmt.Println("Matrix: Skipped (not configured)")
+	}
+
+	// Test generic webhooks
+	if urls := os.Getenv("WEBHOOK_URLS"); urls != "" {
+		fmt.Println("\nSending webhooks...")
+		webhook := notifier.NewWebhook(os.Getenv("WEBHOOK_SECRET"), env.SplitList(urls)...)
+		webhook.Run = notifier.WebhookRun{ID: "test", StartedAt: time.Now().UTC()}
+		if err := webhook.SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("Webhook error: %v\n", err)
+		} else {
+			fmt.Println("Webhook: Success!")
+		}
+	} else {
+		fmt.Println("Webhook: Skipped (not configured)")
+	}
+
+	// Test group robots
+	if webhookURL := os.Getenv("FEISHU_WEBHOOK_URL"); webhookURL != "" {
+		fmt.Println("\nSending Feishu card...")
+		if err := notifier.NewFeishu(webhookURL, os.Getenv("FEISHU_SECRET")).SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("Feishu error: %v\n", err)
+		} else {
+			fmt.Println("Feishu: Success!")
+		}
+	} else {
+		fmt.Println("Feishu: Skipped (not configured)")
+	}
+	if webhookURL := os.Getenv("DINGTALK_WEBHOOK_URL"); webhookURL != "" {
+		fmt.Println("\nSending DingTalk message...")
+		if err := notifier.NewDingTalk(webhookURL, os.Getenv("DINGTALK_SECRET")).SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("DingTalk error: %v\n", err)
+		} else {
+			fmt.Println("DingTalk: Success!")
+		}
+	} else {
+		fmt.Println("DingTalk: Skipped (not configured)")
+	}
+	if webhookURL := os.Getenv("WECOM_WEBHOOK_URL"); webhookURL != "" {
+		fmt.Println("\nSending WeCom message...")
+		if err := notifier.NewWeCom(webhookURL).SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("WeCom error: %v\n", err)
+		} else {
+			fmt.Println("WeCom: Success!")
+		}
+	} else {
+		fmt.Println("WeCom: Skipped (not configured)")
+	}
+
+	// Test Telegram
+	telegramToken := os.Getenv("TELEGRAM_BOT_TOKEN")
+	telegramChat := os.Getenv("TELEGRAM_CHAT_ID")
+	if telegramToken != "" && telegramChat != "" {
+		fmt.Println("\nSending Telegram message...")
+		telegram := notifier.NewTelegram(telegramToken, telegramChat)
+		if err := telegram.Send(ctx, mentions); err != nil {
+			fmt.Printf("Telegram error: %v\n", err)
+		} else {
+			fmt.Println("Telegram: Success!")
+		}
+	} else {
+		fmt.Println("Telegram: Skipped (not configured)")
+	}
+
+	// Test email
+	smtpHost := os.Getenv("SMTP_HOST")
+	emailTo := os.Getenv("EMAIL_TO")
+	if smtpHost != "" && emailTo != "" {
+		fmt.Println("\nSending email...")
+		smtpPort, _ := strconv.Atoi(os.Getenv("SMTP_PORT"))
+		email := notifier.NewEmail(smtpHost, smtpPort, os.Getenv("EMAIL_FROM"), env.SplitList(emailTo))
+		email.Username = os.Getenv("SMTP_USERNAME")
+		email.Password = os.Getenv("SMTP_PASSWORD")
+		if tlsMode := os.Getenv("SMTP_TLS"); tlsMode != "" {
+			email.TLS = tlsMode
+		}
+		if err := email.SendBatch(ctx, mentions); err != nil {
+			fmt.Printf("Email error: %v\n", err)
+		} else {
+			fmt.Println("Email: Success!")
+		}
+	} else {
+		fmt.Println("Email: Skipped (not configured)")
+	}
+
 	fmt.Println("\nTest complete!")
 }
@@ -0,0 +1,320 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// AwesomeList tracks when keywords are added to or removed from curated
+// "awesome-*" lists
+type AwesomeList struct {
+	// Lists are GitHub repositories ("owner/repo" or repository URLs) or raw README URLs
+	Lists []string
+	// Discover also searches GitHub for awesome lists whose README mentions a keyword
+	Discover bool
+	Token    string
+	// State stores the matching entries of each list between runs
+	State map[string]string
+}
+
+// awesomeEntry is one Markdown link entry of a list
+type awesomeEntry struct {
+	Section     string `json:"section"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+type ghRepoSearchResponse struct {
+	Items []ghRepo `json:"items"`
+}
+
+type ghRepo struct {
+	FullName string `json:"full_name"`
+}
+
+var (
+	awesomeHeadingRe = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	awesomeEntryRe   = regexp.MustCompile(`^\s*[-*+]\s+\*{0,2}\[([^\]]+)\]\(([^)\s]+)[^)]*\)\*{0,2}\s*(.*)$`)
+	awesomeLinkRe    = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+)
+
+func (a *AwesomeList) Name() string { return "awesome" }
+
+func (a *AwesomeList) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	lists := append([]string(nil), a.Lists...)
+	if a.Discover {
+		for _, kw := range keywords {
+			discovered, err := a.discover(ctx, kw)
+			if err != nil {
+				continue
+			}
+			lists = append(lists, discovered...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var mentions []models.Mention
+
+	for i, list := range lists {
+		repo, readmeURL := awesomeSource(list)
+		if seen[readmeURL] {
+			continue
+		}
+		seen[readmeURL] = true
+
+		// Configured lists come first; the rest were discovered
+		results, err := a.check(ctx, repo, readmeURL, keywords, i >= len(a.Lists))
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+// discover returns awesome lists whose README mentions the keyword
+func (a *AwesomeList) discover(ctx context.Context, keyword string) ([]string, error) {
+	query := fmt.Sprintf("%q in:readme topic:awesome-list", keyword)
+	apiURL := fmt.Sprintf("https://api.github.com/search/repositories?q=%s&sort=stars&order=desc&per_page=10", url.QueryEscape(query))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	if a.Token != "" {
+		req.Header.Set("Authorization", "token "+a.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("github repository search returned status %d", resp.StatusCode)
+	}
+
+	var result ghRepoSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var lists []string
+	for _, repo := range result.Items {
+		lists = append(lists, repo.FullName)
+	}
+	return lists, nil
+}
+
+// awesomeSource resolves a configured list to its repository name (if on GitHub)
+// and the URL its README is fetched from
+func awesomeSource(list string) (string, string) {
+	list = strings.TrimSuffix(strings.TrimSpace(list), "/")
+
+	repo := ""
+	if !strings.Contains(list, "://") && strings.Count(list, "/") == 1 {
+		repo = list
+	} else if u, err := url.Parse(list); err == nil && u.Host == "github.com" {
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) == 2 {
+			repo = parts[0] + "/" + strings.TrimSuffix(parts[1], ".git")
+		}
+	}
+
+	if repo == "" {
+		return "", list
+	}
+	return repo, fmt.Sprintf("https://api.github.com/repos/%s/readme", repo)
+}
+
+// check reports entries added to or removed from a list since the last run.
+// The first check of a configured list only records its entries; a discovered
+// list is only found once it mentions a keyword, so its matching entries are
+// reported as added.
+func (a *AwesomeList) check(ctx context.Context, repo, readmeURL string, keywords []string, discovered bool) ([]models.Mention, error) {
+	readme, err := a.fetchReadme(ctx, readmeURL, repo != "")
+	if err != nil {
+		return nil, err
+	}
+
+	var current []awesomeEntry
+	for _, e := range parseAwesomeEntries(readme) {
+		if found, _ := ContainsKeyword(e.Name+" "+e.URL+" "+e.Description, keywords); found {
+			current = append(current, e)
+		}
+	}
+
+	if a.State == nil {
+		return nil, nil
+	}
+
+	key := "awesome_" + shortHash(readmeURL)
+	p, seeded := a.State[key]
+	snapshot, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+	a.State[key] = string(snapshot)
+
+	if !seeded && !discovered {
+		return nil, nil
+	}
+
+	var previous []awesomeEntry
+	if seeded {
+		if err := json.Unmarshal([]byte(p), &previous); err != nil {
+			return nil, err
+		}
+	}
+
+	listName, listURL := repo, readmeURL
+	if repo != "" {
+		listURL = "https://github.com/" + repo
+	} else if u, err := url.Parse(readmeURL); err == nil {
+		listName = u.Host + u.Path
+	}
+
+	before := make(map[string]awesomeEntry)
+	for _, e := range previous {
+		before[e.URL] = e
+	}
+	after := make(map[string]awesomeEntry)
+	for _, e := range current {
+		after[e.URL] = e
+	}
+
+	// Entries are told apart by day, so an entry removed and later re-added is reported again
+	day := time.Now().UTC().Format("20060102")
+
+	var mentions []models.Mention
+	for _, e := range current {
+		if _, ok := before[e.URL]; ok {
+			continue
+		}
+		mentions = append(mentions, awesomeMention(e, "added", listName, listURL, day, keywords))
+	}
+	for _, e := range previous {
+		if _, ok := after[e.URL]; ok {
+			continue
+		}
+		mentions = append(mentions, awesomeMention(e, "removed", listName, listURL, day, keywords))
+	}
+
+	return mentions, nil
+}
+
+func awesomeMention(e awesomeEntry, change, listName, listURL, day string, keywords []string) models.Mention {
+	_, matchedKw := ContainsKeyword(e.Name+" "+e.URL+" "+e.Description, keywords)
+
+	verb := "Added to"
+	if change == "removed" {
+		verb = "Removed from"
+	}
+	title := fmt.Sprintf("%s %s", verb, listName)
+	if e.Section != "" {
+		title = fmt.Sprintf("%s %s under %s", verb, listName, e.Section)
+	}
+
+	content := fmt.Sprintf("%s (%s)", e.Name, e.URL)
+	if e.Description != "" {
+		content += " " + e.Description
+	}
+
+	return models.Mention{
+		ID:           fmt.Sprintf("awesome_%s_%s_%s_%s", change, shortHash(listURL), shortHash(e.URL), day),
+		Source:       "awesome",
+		Type:         change,
+		Keyword:      matchedKw,
+		Title:        title,
+		Content:      truncate(content, 500),
+		URL:          listURL,
+		DiscoveredAt: time.Now().UTC(),
+		Community:    listName,
+	}
+}
+
+func (a *AwesomeList) fetchReadme(ctx context.Context, readmeURL string, githubAPI bool) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", readmeURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	if githubAPI {
+		req.Header.Set("Accept", "application/vnd.github.raw")
+		if a.Token != "" {
+			req.Header.Set("Authorization", "token "+a.Token)
+		}
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("%s returned status %d", readmeURL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 5<<20))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// parseAwesomeEntries extracts "- [name](url) description" entries with the
+// heading they appear under, skipping fenced code blocks
+func parseAwesomeEntries(markdown string) []awesomeEntry {
+	var entries []awesomeEntry
+	section := ""
+	inCode := false
+
+	for _, line := range strings.Split(markdown, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+
+		if m := awesomeHeadingRe.FindStringSubmatch(line); m != nil {
+			section = awesomeLinkRe.ReplaceAllString(m[1], "$1")
+			continue
+		}
+
+		m := awesomeEntryRe.FindStringSubmatch(line)
+		if m == nil || strings.HasPrefix(m[2], "#") {
+			// Links to anchors are the table of contents
+			continue
+		}
+
+		description := strings.TrimSpace(m[3])
+		description = strings.TrimLeft(description, "-–—: ")
+		description = awesomeLinkRe.ReplaceAllString(description, "$1")
+
+		entries = append(entries, awesomeEntry{
+			Section:     section,
+			Name:        m[1],
+			URL:         m[2],
+			Description: description,
+		})
+	}
+
+	return entries
+}
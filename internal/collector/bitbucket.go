@@ -0,0 +1,206 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Bitbucket collects mentions from Bitbucket Cloud issues, pull requests and code
+type Bitbucket struct {
+	// Username and AppPassword authenticate API requests (code search requires them)
+	Username    string
+	AppPassword string
+	// Workspaces are searched for code
+	Workspaces []string
+	// Repositories ("workspace/repo") are searched for issues and pull requests
+	Repositories []string
+}
+
+type bbIssuePage struct {
+	Values []bbIssue `json:"values"`
+}
+
+type bbCodePage struct {
+	Values []bbCodeResult `json:"values"`
+}
+
+type bbIssue struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Content     bbContent `json:"content"`     // issues
+	Description string    `json:"description"` // pull requests
+	Links       bbLinks   `json:"links"`
+	Reporter    *bbUser   `json:"reporter"`
+	Author      *bbUser   `json:"author"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+type bbContent struct {
+	Raw string `json:"raw"`
+}
+
+type bbLinks struct {
+	HTML struct {
+		Href string `json:"href"`
+	} `json:"html"`
+}
+
+type bbUser struct {
+	DisplayName string `json:"display_name"`
+}
+
+type bbCodeResult struct {
+	File struct {
+		Path   string `json:"path"`
+		Commit struct {
+			Hash       string `json:"hash"`
+			Repository struct {
+				FullName string `json:"full_name"`
+			} `json:"repository"`
+		} `json:"commit"`
+	} `json:"file"`
+}
+
+func (b *Bitbucket) Name() string { return "bitbucket" }
+
+func (b *Bitbucket) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, kw := range keywords {
+		for _, repo := range b.Repositories {
+			// Pull requests are reported as issues, like GitHub
+			for _, kind := range []string{"issues", "pullrequests"} {
+				results, err := b.searchIssues(ctx, repo, kind, kw)
+				if err != nil {
+					continue
+				}
+				mentions = append(mentions, results...)
+			}
+		}
+
+		for _, workspace := range b.Workspaces {
+			code, err := b.searchCode(ctx, workspace, kw)
+			if err != nil {
+				continue
+			}
+			mentions = append(mentions, code...)
+		}
+	}
+
+	return mentions, nil
+}
+
+func (b *Bitbucket) get(ctx context.Context, apiURL string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	if b.Username != "" {
+		req.SetBasicAuth(b.Username, b.AppPassword)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("bitbucket returned status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (b *Bitbucket) searchIssues(ctx context.Context, repo, kind, keyword string) ([]models.Mention, error) {
+	// Search items created in last 24 hours
+	since := time.Now().Add(-24 * time.Hour).UTC().Format("2006-01-02T15:04:05-07:00")
+	escaped := strings.ReplaceAll(keyword, `"`, `\"`)
+	query := fmt.Sprintf(`(title ~ "%s" OR %s ~ "%s") AND created_on > %s`, escaped, bbBodyField(kind), escaped, since)
+	apiURL := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s/%s?q=%s&sort=-created_on",
+		repo, kind, url.QueryEscape(query))
+
+	var page bbIssuePage
+	if err := b.get(ctx, apiURL, &page); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, item := range page.Values {
+		body := item.Content.Raw
+		if body == "" {
+			body = item.Description
+		}
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("bitbucket_%s_%s_%d", repo, kind, item.ID),
+			Source:       "bitbucket",
+			Type:         "issue",
+			Keyword:      keyword,
+			Title:        item.Title,
+			Content:      truncate(body, 500),
+			URL:          item.Links.HTML.Href,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  item.CreatedOn,
+			Community:    repo,
+		}
+
+		if item.Reporter != nil {
+			m.Author = item.Reporter.DisplayName
+		} else if item.Author != nil {
+			m.Author = item.Author.DisplayName
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
+
+// bbBodyField is the description field used in BBQL queries for each item kind
+func bbBodyField(kind string) string {
+	if kind == "pullrequests" {
+		return "description"
+	}
+	return "content.raw"
+}
+
+func (b *Bitbucket) searchCode(ctx context.Context, workspace, keyword string) ([]models.Mention, error) {
+	// Search for package imports (limited to go.mod files), like the GitHub collector
+	query := fmt.Sprintf(`"%s" path:go.mod`, keyword)
+	apiURL := fmt.Sprintf("https://api.bitbucket.org/2.0/workspaces/%s/search/code?search_query=%s&pagelen=10",
+		url.PathEscape(workspace), url.QueryEscape(query))
+
+	var page bbCodePage
+	if err := b.get(ctx, apiURL, &page); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, result := range page.Values {
+		repo := result.File.Commit.Repository.FullName
+		path := result.File.Path
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("bitbucket_code_%s_%s", repo, path),
+			Source:       "bitbucket",
+			Type:         "code",
+			Keyword:      keyword,
+			Title:        fmt.Sprintf("Used in %s", repo),
+			Content:      fmt.Sprintf("Found in %s", path),
+			URL:          fmt.Sprintf("https://bitbucket.org/%s/src/%s/%s", repo, result.File.Commit.Hash, path),
+			Author:       repo,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	return mentions, nil
+}
@@ -3,6 +3,7 @@ package collector
 import (
 	"context"
 	"strings"
+	"unicode"
 
 	"github.com/rebelice/mention-monitor/internal/models"
 )
@@ -37,13 +38,28 @@ func (c *Collector) CollectAll(ctx context.Context, keywords []string) []models.
 	return all
 }
 
-// ContainsKeyword checks if text contains any of the keywords (case-insensitive)
+// ContainsKeyword checks if text contains any of the keywords
+// (case-insensitive, and treating full-width and half-width forms as equal)
 func ContainsKeyword(text string, keywords []string) (bool, string) {
-	lower := strings.ToLower(text)
+	normalized := normalizeText(text)
 	for _, kw := range keywords {
-		if strings.Contains(lower, strings.ToLower(kw)) {
+		if strings.Contains(normalized, normalizeText(kw)) {
 			return true, kw
 		}
 	}
 	return false, ""
 }
+
+// normalizeText lowercases text and folds full-width ASCII, common in CJK
+// input methods (e.g. "ｌａｚｙｐｇ"), to its half-width form
+func normalizeText(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			r -= '！' - '!'
+		case r == '\u3000': // Ideographic space
+			r = ' '
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,265 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// CratesIO collects crates that depend on our crates via the crates.io API
+type CratesIO struct {
+	// Crates are the crate names to watch for new dependents
+	Crates []string
+	// State remembers the dependents already seen, so only new ones are reported
+	State map[string]string
+}
+
+type cratesReverseDepsResponse struct {
+	Versions []cratesVersion `json:"versions"`
+	Meta     struct {
+		Total int `json:"total"`
+	} `json:"meta"`
+}
+
+// cratesMaxDependentPages bounds how many pages of 100 dependents are read;
+// crates.io sorts them by downloads, so new dependents can be on any page
+const cratesMaxDependentPages = 50
+
+type cratesVersion struct {
+	Crate       string        `json:"crate"`
+	Num         string        `json:"num"`
+	CreatedAt   time.Time     `json:"created_at"`
+	PublishedBy *cratesPerson `json:"published_by"`
+}
+
+type cratesPerson struct {
+	Login string `json:"login"`
+}
+
+type cratesSummaryResponse struct {
+	NewCrates   []cratesCrate `json:"new_crates"`
+	JustUpdated []cratesCrate `json:"just_updated"`
+}
+
+type cratesCrate struct {
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	NewestVersion string    `json:"newest_version"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// crates.io requires a descriptive User-Agent
+const cratesUserAgent = "mention-monitor/1.0 (https://github.com/rebelice/mention-monitor)"
+
+func (c *CratesIO) Name() string { return "crates" }
+
+func (c *CratesIO) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	if len(c.Crates) == 0 {
+		return nil, nil
+	}
+
+	var mentions []models.Mention
+
+	for _, name := range c.Crates {
+		results, err := c.reverseDependencies(ctx, name)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	releases, err := c.releases(ctx, keywords)
+	if err == nil {
+		mentions = append(mentions, releases...)
+	}
+
+	return mentions, nil
+}
+
+// reverseDependencies reports dependents not seen in previous runs. The first
+// run only records the existing dependents.
+func (c *CratesIO) reverseDependencies(ctx context.Context, name string) ([]models.Mention, error) {
+	if c.State == nil {
+		return nil, nil
+	}
+
+	var versions []cratesVersion
+	for page := 1; page <= cratesMaxDependentPages; page++ {
+		result, err := c.reverseDependenciesPage(ctx, name, page)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, result.Versions...)
+		if len(result.Versions) == 0 || len(versions) >= result.Meta.Total {
+			break
+		}
+	}
+
+	key := "crates_dependents_" + name
+	previous, seeded := c.State[key]
+	seen := make(map[string]bool)
+	if seeded {
+		var names []string
+		if err := json.Unmarshal([]byte(previous), &names); err != nil {
+			return nil, err
+		}
+		for _, n := range names {
+			seen[n] = true
+		}
+	}
+
+	var mentions []models.Mention
+	for _, v := range versions {
+		if seen[v.Crate] {
+			continue
+		}
+		seen[v.Crate] = true
+		if !seeded {
+			continue
+		}
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("crates_%s_%s", name, v.Crate),
+			Source:       "crates",
+			Type:         "import",
+			Keyword:      name,
+			Title:        fmt.Sprintf("Depended on by %s", v.Crate),
+			Content:      fmt.Sprintf("Crate %s %s depends on %s", v.Crate, v.Num, name),
+			URL:          fmt.Sprintf("https://crates.io/crates/%s", v.Crate),
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  v.CreatedAt,
+		}
+
+		if v.PublishedBy != nil {
+			m.Author = v.PublishedBy.Login
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	// Keep dependents that dropped off the fetched pages, so they aren't
+	// reported again if they come back
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	snapshot, err := json.Marshal(names)
+	if err != nil {
+		return nil, err
+	}
+	c.State[key] = string(snapshot)
+
+	return mentions, nil
+}
+
+func (c *CratesIO) reverseDependenciesPage(ctx context.Context, name string, page int) (*cratesReverseDepsResponse, error) {
+	apiURL := fmt.Sprintf("https://crates.io/api/v1/crates/%s/reverse_dependencies?per_page=100&page=%d", url.PathEscape(name), page)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", cratesUserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("crates.io returned status %d", resp.StatusCode)
+	}
+
+	var result cratesReverseDepsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// releases checks the READMEs of newly created and updated crates for keywords
+func (c *CratesIO) releases(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://crates.io/api/v1/summary", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", cratesUserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("crates.io returned status %d", resp.StatusCode)
+	}
+
+	var summary cratesSummaryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, crate := range append(summary.NewCrates, summary.JustUpdated...) {
+		readme, err := c.readme(ctx, crate.Name, crate.NewestVersion)
+		if err != nil {
+			continue
+		}
+
+		found, matchedKw := ContainsKeyword(readme+" "+crate.Description, keywords)
+		if !found {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("crates_release_%s_%s", crate.Name, crate.NewestVersion),
+			Source:       "crates",
+			Type:         "release",
+			Keyword:      matchedKw,
+			Title:        fmt.Sprintf("%s %s mentions %s", crate.Name, crate.NewestVersion, matchedKw),
+			Content:      truncate(crate.Description, 500),
+			URL:          fmt.Sprintf("https://crates.io/crates/%s", crate.Name),
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  crate.UpdatedAt,
+		})
+	}
+
+	return mentions, nil
+}
+
+func (c *CratesIO) readme(ctx context.Context, name, version string) (string, error) {
+	apiURL := fmt.Sprintf("https://crates.io/api/v1/crates/%s/%s/readme", url.PathEscape(name), url.PathEscape(version))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", cratesUserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("crates.io returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,155 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Discord collects mentions from Discord channels a bot can read, since the last seen message
+type Discord struct {
+	BotToken   string
+	ChannelIDs []string
+	// APIURL is the Discord API base (default: https://discord.com/api/v10)
+	APIURL string
+	// State stores the last seen message ID per channel between runs
+	State map[string]string
+}
+
+type discordChannel struct {
+	ID      string `json:"id"`
+	GuildID string `json:"guild_id"`
+	Name    string `json:"name"`
+}
+
+type discordMessage struct {
+	ID        string        `json:"id"`
+	Content   string        `json:"content"`
+	Timestamp time.Time     `json:"timestamp"`
+	Author    discordAuthor `json:"author"`
+}
+
+type discordAuthor struct {
+	Username   string `json:"username"`
+	GlobalName string `json:"global_name"`
+}
+
+func (d *Discord) Name() string { return "discord" }
+
+func (d *Discord) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	if d.BotToken == "" {
+		return nil, nil
+	}
+
+	var mentions []models.Mention
+
+	for _, channelID := range d.ChannelIDs {
+		results, err := d.channelMessages(ctx, channelID, keywords)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+func (d *Discord) get(ctx context.Context, path string, out interface{}) error {
+	apiURL := d.APIURL
+	if apiURL == "" {
+		apiURL = "https://discord.com/api/v10"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", strings.TrimSuffix(apiURL, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bot "+d.BotToken)
+	req.Header.Set("User-Agent", "DiscordBot (https://github.com/rebelice/mention-monitor, 1.0)")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("discord returned status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (d *Discord) channelMessages(ctx context.Context, channelID string, keywords []string) ([]models.Mention, error) {
+	// The guild ID is needed to build message links
+	var channel discordChannel
+	if err := d.get(ctx, "/channels/"+url.PathEscape(channelID), &channel); err != nil {
+		return nil, err
+	}
+
+	stateKey := "discord_" + channelID
+	seeded := d.State != nil && d.State[stateKey] != ""
+	params := url.Values{}
+	if seeded {
+		params.Set("limit", "100")
+		params.Set("after", d.State[stateKey])
+	} else {
+		// First run: only record the newest message instead of reporting history
+		params.Set("limit", "1")
+	}
+
+	var messages []discordMessage
+	if err := d.get(ctx, fmt.Sprintf("/channels/%s/messages?%s", url.PathEscape(channelID), params.Encode()), &messages); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, msg := range messages {
+		if d.State != nil && snowflakeAfter(msg.ID, d.State[stateKey]) {
+			d.State[stateKey] = msg.ID
+		}
+		if !seeded {
+			continue
+		}
+
+		found, matchedKw := ContainsKeyword(msg.Content, keywords)
+		if !found {
+			continue
+		}
+
+		author := msg.Author.GlobalName
+		if author == "" {
+			author = msg.Author.Username
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("discord_%s", msg.ID),
+			Source:       "discord",
+			Type:         "message",
+			Keyword:      matchedKw,
+			Title:        truncate(firstLine(msg.Content), 100),
+			Content:      truncate(msg.Content, 500),
+			URL:          fmt.Sprintf("https://discord.com/channels/%s/%s/%s", channel.GuildID, channelID, msg.ID),
+			Author:       author,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  msg.Timestamp,
+			Community:    channel.Name,
+		})
+	}
+
+	return mentions, nil
+}
+
+// snowflakeAfter reports whether Discord ID a is newer than b
+func snowflakeAfter(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) > len(b)
+	}
+	return a > b
+}
@@ -0,0 +1,139 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Discourse collects mentions from Discourse forums via the search API
+type Discourse struct {
+	// Forums are forum base URLs (e.g., "https://forum.golangbridge.org")
+	Forums []string
+}
+
+// DefaultDiscourseForums are searched when no forums are configured
+var DefaultDiscourseForums = []string{
+	"https://forum.golangbridge.org",
+}
+
+type discourseSearchResponse struct {
+	Posts  []discoursePost  `json:"posts"`
+	Topics []discourseTopic `json:"topics"`
+}
+
+type discoursePost struct {
+	ID         int       `json:"id"`
+	Username   string    `json:"username"`
+	CreatedAt  time.Time `json:"created_at"`
+	Blurb      string    `json:"blurb"`
+	PostNumber int       `json:"post_number"`
+	TopicID    int       `json:"topic_id"`
+	LikeCount  int       `json:"like_count"`
+}
+
+type discourseTopic struct {
+	ID         int    `json:"id"`
+	Title      string `json:"title"`
+	Slug       string `json:"slug"`
+	PostsCount int    `json:"posts_count"`
+}
+
+func (d *Discourse) Name() string { return "discourse" }
+
+func (d *Discourse) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	forums := d.Forums
+	if len(forums) == 0 {
+		forums = DefaultDiscourseForums
+	}
+
+	var mentions []models.Mention
+
+	for _, forum := range forums {
+		for _, kw := range keywords {
+			results, err := d.search(ctx, strings.TrimSuffix(forum, "/"), kw)
+			if err != nil {
+				continue
+			}
+			mentions = append(mentions, results...)
+		}
+	}
+
+	return mentions, nil
+}
+
+func (d *Discourse) search(ctx context.Context, baseURL, keyword string) ([]models.Mention, error) {
+	apiURL := fmt.Sprintf("%s/search.json?q=%s", baseURL, url.QueryEscape(keyword+" order:latest"))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("discourse %s returned status %d", baseURL, resp.StatusCode)
+	}
+
+	var result discourseSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	topics := make(map[int]discourseTopic)
+	for _, t := range result.Topics {
+		topics[t.ID] = t
+	}
+
+	forum := baseURL
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		forum = u.Host
+	}
+
+	var mentions []models.Mention
+	for _, p := range result.Posts {
+		topic := topics[p.TopicID]
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("discourse_%s_%d", forum, p.ID),
+			Source:       "discourse",
+			Keyword:      keyword,
+			Content:      p.Blurb,
+			URL:          fmt.Sprintf("%s/t/%s/%d/%d", baseURL, topic.Slug, p.TopicID, p.PostNumber),
+			Author:       p.Username,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  p.CreatedAt,
+			Community:    forum,
+			Score:        p.LikeCount,
+		}
+
+		// The first post of a topic is the topic itself
+		if p.PostNumber == 1 {
+			m.Type = "topic"
+			m.Title = topic.Title
+			if topic.PostsCount > 0 {
+				m.CommentCount = topic.PostsCount - 1
+			}
+		} else {
+			m.Type = "post"
+			m.Title = fmt.Sprintf("Reply in: %s", topic.Title)
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
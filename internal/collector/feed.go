@@ -0,0 +1,179 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// FeedConfig describes one RSS, Atom or JSON Feed to monitor
+type FeedConfig struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+	// SiteURL is the feed's website, kept for OPML round-trips
+	SiteURL string `json:"site_url,omitempty"`
+	// Source is stored as Mention.Source (default: "feed")
+	Source string `json:"source,omitempty"`
+	// Type is stored as Mention.Type (default: "article")
+	Type string `json:"type,omitempty"`
+	// Filter keeps only items that contain one of the keywords
+	Filter bool `json:"filter,omitempty"`
+	// Tags group feeds, e.g. by OPML category
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Feed collects mentions from arbitrary RSS, Atom and JSON feeds
+type Feed struct {
+	Feeds []FeedConfig
+}
+
+func (f *Feed) Name() string { return "feed" }
+
+func (f *Feed) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, cfg := range f.Feeds {
+		results, err := f.fetch(ctx, cfg, keywords)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+func (f *Feed) fetch(ctx context.Context, cfg FeedConfig, keywords []string) ([]models.Mention, error) {
+	source := cfg.Source
+	if source == "" {
+		source = "feed"
+	}
+	contentType := cfg.Type
+	if contentType == "" {
+		contentType = "article"
+	}
+
+	feed, err := fetchFeed(ctx, cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, item := range feed.Items {
+		text := item.Title + " " + item.Description + " " + item.Content
+		// Unfiltered feeds keep every item, labelled only when it matches a keyword
+		found, matchedKw := ContainsKeyword(text, keywords)
+		if !found && cfg.Filter {
+			continue
+		}
+
+		m := feedItemMention(item, source, contentType, matchedKw)
+		if m.Author == "" {
+			m.Author = feed.Title
+		}
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
+
+// LoadFeeds reads feed configuration from a JSON file; a missing file means no feeds
+func LoadFeeds(path string) ([]FeedConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var feeds []FeedConfig
+	if err := json.Unmarshal(data, &feeds); err != nil {
+		return nil, fmt.Errorf("invalid feeds file %s: %w", path, err)
+	}
+	return feeds, nil
+}
+
+// SaveFeeds writes feed configuration to a JSON file
+func SaveFeeds(path string, feeds []FeedConfig) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(feeds, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// fetchFeed downloads and parses an RSS, Atom or JSON feed
+func fetchFeed(ctx context.Context, feedURL string) (*gofeed.Feed, error) {
+	feed, _, err := fetchFeedStatus(ctx, feedURL)
+	return feed, err
+}
+
+// fetchFeedStatus is fetchFeed that also returns the response status code,
+// which is zero when the request itself failed
+func fetchFeedStatus(ctx context.Context, feedURL string) (*gofeed.Feed, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", feedURL, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		host := feedURL
+		if u, err := url.Parse(feedURL); err == nil {
+			host = u.Host
+		}
+		return nil, resp.StatusCode, fmt.Errorf("%s returned status %d", host, resp.StatusCode)
+	}
+
+	fp := gofeed.NewParser()
+	feed, err := fp.Parse(resp.Body)
+	return feed, resp.StatusCode, err
+}
+
+// feedItemMention maps a feed item to a mention with the given labels
+func feedItemMention(item *gofeed.Item, source, contentType, keyword string) models.Mention {
+	guid := item.GUID
+	if guid == "" {
+		guid = item.Link
+	}
+
+	m := models.Mention{
+		ID:           fmt.Sprintf("%s_%s", source, guid),
+		Source:       source,
+		Type:         contentType,
+		Keyword:      keyword,
+		Title:        item.Title,
+		Content:      truncate(item.Description, 500),
+		URL:          item.Link,
+		DiscoveredAt: time.Now().UTC(),
+	}
+
+	if item.Author != nil {
+		m.Author = item.Author.Name
+	}
+	if item.PublishedParsed != nil {
+		m.PublishedAt = *item.PublishedParsed
+	}
+
+	return m
+}
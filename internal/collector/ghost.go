@@ -0,0 +1,127 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Ghost collects mentions from self-hosted Ghost blogs via the Content API
+type Ghost struct {
+	Sites []GhostSite
+}
+
+// GhostSite is a Ghost blog and its Content API key (Settings → Integrations)
+type GhostSite struct {
+	URL string
+	Key string
+}
+
+type ghostPostsResponse struct {
+	Posts []ghostPost `json:"posts"`
+}
+
+type ghostPost struct {
+	ID            string       `json:"id"`
+	Title         string       `json:"title"`
+	URL           string       `json:"url"`
+	Excerpt       string       `json:"excerpt"`
+	Plaintext     string       `json:"plaintext"`
+	PublishedAt   time.Time    `json:"published_at"`
+	PrimaryAuthor *ghostAuthor `json:"primary_author"`
+}
+
+type ghostAuthor struct {
+	Name string `json:"name"`
+}
+
+func (g *Ghost) Name() string { return "ghost" }
+
+func (g *Ghost) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, site := range g.Sites {
+		results, err := g.posts(ctx, site, keywords)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+func (g *Ghost) posts(ctx context.Context, site GhostSite, keywords []string) ([]models.Mention, error) {
+	baseURL := strings.TrimSuffix(site.URL, "/")
+	params := url.Values{}
+	params.Set("key", site.Key)
+	params.Set("limit", "50")
+	params.Set("order", "published_at desc")
+	params.Set("formats", "plaintext")
+	params.Set("include", "authors")
+	apiURL := fmt.Sprintf("%s/ghost/api/content/posts/?%s", baseURL, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	req.Header.Set("Accept-Version", "v5.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("ghost %s returned status %d", baseURL, resp.StatusCode)
+	}
+
+	var result ghostPostsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	host := baseURL
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	var mentions []models.Mention
+	for _, p := range result.Posts {
+		// Full-text match on the post body
+		text := p.Title + " " + p.Plaintext
+		found, matchedKw := ContainsKeyword(text, keywords)
+		if !found {
+			continue
+		}
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("ghost_%s_%s", host, p.ID),
+			Source:       "ghost",
+			Type:         "article",
+			Keyword:      matchedKw,
+			Title:        p.Title,
+			Content:      truncate(p.Excerpt, 500),
+			URL:          p.URL,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  p.PublishedAt,
+			Community:    host,
+		}
+
+		if p.PrimaryAuthor != nil {
+			m.Author = p.PrimaryAuthor.Name
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
@@ -0,0 +1,120 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Gitea collects mentions from Gitea and Forgejo instances such as Codeberg.
+// Code isn't searched: neither API offers a cross-repository code search.
+type Gitea struct {
+	// Instances default to codeberg.org
+	Instances []ForgeInstance
+}
+
+type giteaIssue struct {
+	ID         int       `json:"id"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	HTMLURL    string    `json:"html_url"`
+	User       giteaUser `json:"user"`
+	CreatedAt  time.Time `json:"created_at"`
+	Comments   int       `json:"comments"`
+	Repository giteaRepo `json:"repository"`
+}
+
+type giteaUser struct {
+	Login string `json:"login"`
+}
+
+type giteaRepo struct {
+	FullName string `json:"full_name"`
+}
+
+func (g *Gitea) Name() string { return "gitea" }
+
+func (g *Gitea) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	instances := g.Instances
+	if len(instances) == 0 {
+		instances = []ForgeInstance{{URL: "https://codeberg.org"}}
+	}
+
+	var mentions []models.Mention
+
+	for _, inst := range instances {
+		inst.URL = strings.TrimSuffix(inst.URL, "/")
+		for _, kw := range keywords {
+			// Pull requests are reported as issues, like GitHub
+			for _, kind := range []string{"issues", "pulls"} {
+				results, err := g.searchIssues(ctx, inst, kind, kw)
+				if err != nil {
+					continue
+				}
+				mentions = append(mentions, results...)
+			}
+		}
+	}
+
+	return mentions, nil
+}
+
+func (g *Gitea) searchIssues(ctx context.Context, inst ForgeInstance, kind, keyword string) ([]models.Mention, error) {
+	// Search issues created in last 24 hours
+	since := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+	apiURL := fmt.Sprintf("%s/api/v1/repos/issues/search?q=%s&type=%s&state=all&since=%s&limit=50",
+		inst.URL, url.QueryEscape(keyword), kind, url.QueryEscape(since))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	req.Header.Set("Accept", "application/json")
+	if inst.Token != "" {
+		req.Header.Set("Authorization", "token "+inst.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("gitea %s returned status %d", inst.URL, resp.StatusCode)
+	}
+
+	var items []giteaIssue
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		return nil, err
+	}
+
+	host := forgeHost(inst.URL)
+
+	var mentions []models.Mention
+	for _, item := range items {
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("gitea_%s_%d", host, item.ID),
+			Source:       "gitea",
+			Type:         "issue",
+			Keyword:      keyword,
+			Title:        item.Title,
+			Content:      truncate(item.Body, 500),
+			URL:          item.HTMLURL,
+			Author:       item.User.Login,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  item.CreatedAt,
+			Community:    item.Repository.FullName,
+			CommentCount: item.Comments,
+		})
+	}
+
+	return mentions, nil
+}
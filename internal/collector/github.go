@@ -169,8 +169,9 @@ func (g *GitHub) searchCode(ctx context.Context, keyword string) ([]models.Menti
 }
 
 func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen] + "..."
+	return string(runes[:maxLen]) + "..."
 }
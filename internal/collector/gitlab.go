@@ -0,0 +1,196 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// GitLab collects mentions from GitLab issues, merge requests and code
+type GitLab struct {
+	// Instances default to gitlab.com; instances without a token are skipped,
+	// since search requires one
+	Instances []ForgeInstance
+}
+
+// ForgeInstance is a (possibly self-hosted) forge and its access token
+type ForgeInstance struct {
+	URL   string
+	Token string
+}
+
+type glIssue struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	WebURL      string    `json:"web_url"`
+	Author      glUser    `json:"author"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type glUser struct {
+	Username string `json:"username"`
+}
+
+type glBlob struct {
+	Path      string `json:"path"`
+	Ref       string `json:"ref"`
+	ProjectID int    `json:"project_id"`
+}
+
+type glProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+func (g *GitLab) Name() string { return "gitlab" }
+
+func (g *GitLab) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	instances := g.Instances
+	if len(instances) == 0 {
+		instances = []ForgeInstance{{URL: "https://gitlab.com"}}
+	}
+
+	var mentions []models.Mention
+
+	for _, inst := range instances {
+		// GitLab's search API answers 401 to anonymous requests
+		if inst.Token == "" {
+			continue
+		}
+		inst.URL = strings.TrimSuffix(inst.URL, "/")
+		for _, kw := range keywords {
+			// Merge requests are reported as issues, like GitHub pull requests
+			for _, scope := range []string{"issues", "merge_requests"} {
+				results, err := g.searchIssues(ctx, inst, scope, kw)
+				if err != nil {
+					continue
+				}
+				mentions = append(mentions, results...)
+			}
+
+			code, err := g.searchCode(ctx, inst, kw)
+			if err != nil {
+				continue
+			}
+			mentions = append(mentions, code...)
+		}
+	}
+
+	return mentions, nil
+}
+
+func (g *GitLab) get(ctx context.Context, inst ForgeInstance, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", inst.URL+"/api/v4"+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	if inst.Token != "" {
+		req.Header.Set("PRIVATE-TOKEN", inst.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("gitlab %s returned status %d", inst.URL, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func (g *GitLab) searchIssues(ctx context.Context, inst ForgeInstance, scope, keyword string) ([]models.Mention, error) {
+	path := fmt.Sprintf("/search?scope=%s&search=%s&order_by=created_at&sort=desc&per_page=50", scope, url.QueryEscape(keyword))
+
+	var items []glIssue
+	if err := g.get(ctx, inst, path, &items); err != nil {
+		return nil, err
+	}
+
+	// Only report items created in the last 24 hours, like the GitHub collector
+	since := time.Now().Add(-24 * time.Hour)
+	host := forgeHost(inst.URL)
+
+	var mentions []models.Mention
+	for _, item := range items {
+		if item.CreatedAt.Before(since) {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("gitlab_%s_%s_%d", host, scope, item.ID),
+			Source:       "gitlab",
+			Type:         "issue",
+			Keyword:      keyword,
+			Title:        item.Title,
+			Content:      truncate(item.Description, 500),
+			URL:          item.WebURL,
+			Author:       item.Author.Username,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  item.CreatedAt,
+		})
+	}
+
+	return mentions, nil
+}
+
+func (g *GitLab) searchCode(ctx context.Context, inst ForgeInstance, keyword string) ([]models.Mention, error) {
+	// Search for package imports, like the GitHub go.mod search
+	query := fmt.Sprintf("%s filename:go.mod", keyword)
+	path := fmt.Sprintf("/search?scope=blobs&search=%s&per_page=10", url.QueryEscape(query))
+
+	var blobs []glBlob
+	if err := g.get(ctx, inst, path, &blobs); err != nil {
+		return nil, err
+	}
+
+	host := forgeHost(inst.URL)
+	projects := make(map[int]*glProject)
+
+	var mentions []models.Mention
+	for _, blob := range blobs {
+		project, ok := projects[blob.ProjectID]
+		if !ok {
+			project = &glProject{}
+			if err := g.get(ctx, inst, fmt.Sprintf("/projects/%d", blob.ProjectID), project); err != nil {
+				project = nil
+			}
+			projects[blob.ProjectID] = project
+		}
+		if project == nil {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("gitlab_code_%s_%s_%s", host, project.PathWithNamespace, blob.Path),
+			Source:       "gitlab",
+			Type:         "code",
+			Keyword:      keyword,
+			Title:        fmt.Sprintf("Used in %s", project.PathWithNamespace),
+			Content:      fmt.Sprintf("Found in %s", blob.Path),
+			URL:          fmt.Sprintf("%s/-/blob/%s/%s", project.WebURL, blob.Ref, blob.Path),
+			Author:       project.PathWithNamespace,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	return mentions, nil
+}
+
+// forgeHost returns the host of a forge base URL for use in mention IDs
+func forgeHost(baseURL string) string {
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return baseURL
+}
@@ -3,11 +3,8 @@ package collector
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
-	"time"
 
-	"github.com/mmcdole/gofeed"
 	"github.com/rebelice/mention-monitor/internal/models"
 )
 
@@ -41,24 +38,7 @@ func (g *Google) Collect(ctx context.Context, keywords []string) ([]models.Menti
 }
 
 func (g *Google) fetchAlertFeed(ctx context.Context, feedURL string, keywords []string) ([]models.Mention, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", feedURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("User-Agent", "mention-monitor/1.0")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("google alerts returned status %d", resp.StatusCode)
-	}
-
-	fp := gofeed.NewParser()
-	feed, err := fp.Parse(resp.Body)
+	feed, err := fetchFeed(ctx, feedURL)
 	if err != nil {
 		return nil, err
 	}
@@ -72,22 +52,7 @@ func (g *Google) fetchAlertFeed(ctx context.Context, feedURL string, keywords []
 			matchedKw = keywords[0] // Default to first keyword for alert feeds
 		}
 
-		mention := models.Mention{
-			ID:           fmt.Sprintf("google_%s", item.GUID),
-			Source:       "google",
-			Type:         "webpage",
-			Keyword:      matchedKw,
-			Title:        item.Title,
-			Content:      truncate(item.Description, 500),
-			URL:          item.Link,
-			DiscoveredAt: time.Now().UTC(),
-		}
-
-		if item.PublishedParsed != nil {
-			mention.PublishedAt = *item.PublishedParsed
-		}
-
-		mentions = append(mentions, mention)
+		mentions = append(mentions, feedItemMention(item, "google", "webpage", matchedKw))
 	}
 
 	return mentions, nil
@@ -100,46 +65,13 @@ func (g *Google) searchBasic(ctx context.Context, keywords []string) ([]models.M
 	for _, kw := range keywords {
 		feedURL := fmt.Sprintf("https://news.google.com/rss/search?q=%s&hl=en-US&gl=US&ceid=US:en", url.QueryEscape(kw))
 
-		req, err := http.NewRequestWithContext(ctx, "GET", feedURL, nil)
-		if err != nil {
-			continue
-		}
-		req.Header.Set("User-Agent", "mention-monitor/1.0")
-
-		resp, err := http.DefaultClient.Do(req)
-		if err != nil {
-			continue
-		}
-
-		if resp.StatusCode != 200 {
-			resp.Body.Close()
-			continue
-		}
-
-		fp := gofeed.NewParser()
-		feed, err := fp.Parse(resp.Body)
-		resp.Body.Close()
+		feed, err := fetchFeed(ctx, feedURL)
 		if err != nil {
 			continue
 		}
 
 		for _, item := range feed.Items {
-			mention := models.Mention{
-				ID:           fmt.Sprintf("google_%s", item.GUID),
-				Source:       "google",
-				Type:         "news",
-				Keyword:      kw,
-				Title:        item.Title,
-				Content:      truncate(item.Description, 500),
-				URL:          item.Link,
-				DiscoveredAt: time.Now().UTC(),
-			}
-
-			if item.PublishedParsed != nil {
-				mention.PublishedAt = *item.PublishedParsed
-			}
-
-			mentions = append(mentions, mention)
+			mentions = append(mentions, feedItemMention(item, "google", "news", kw))
 		}
 	}
 
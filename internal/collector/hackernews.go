@@ -6,13 +6,20 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/rebelice/mention-monitor/internal/models"
 )
 
 // HackerNews collects mentions from Hacker News via Algolia API
-type HackerNews struct{}
+type HackerNews struct {
+	// FetchParent also fetches the text of the comment a matching comment replies to
+	FetchParent bool
+
+	// stories caches story details by ID for the duration of a run
+	stories map[int]hnHit
+}
 
 type hnSearchResponse struct {
 	Hits []hnHit `json:"hits"`
@@ -27,14 +34,25 @@ type hnHit struct {
 	CommentText string   `json:"comment_text"`
 	StoryTitle  string   `json:"story_title"`
 	StoryURL    string   `json:"story_url"`
+	StoryID     int      `json:"story_id"`
 	ParentID    int      `json:"parent_id"`
+	Points      int      `json:"points"`
+	NumComments int      `json:"num_comments"`
 	CreatedAt   string   `json:"created_at"`
 	Tags        []string `json:"_tags"`
 }
 
+// hnItem is an item from the official Hacker News API
+type hnItem struct {
+	By   string `json:"by"`
+	Text string `json:"text"`
+}
+
 func (h *HackerNews) Name() string { return "hackernews" }
 
 func (h *HackerNews) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	h.stories = make(map[int]hnHit)
+
 	var mentions []models.Mention
 
 	for _, kw := range keywords {
@@ -101,11 +119,19 @@ func (h *HackerNews) search(ctx context.Context, keyword, tag string) ([]models.
 			m.Title = hit.Title
 			m.Content = hit.StoryText
 			m.URL = fmt.Sprintf("https://news.ycombinator.com/item?id=%s", hit.ObjectID)
+			m.ThreadID = m.ID
+			m.ThreadURL = hit.URL
+			m.Score = hit.Points
+			m.CommentCount = hit.NumComments
+			if id, err := strconv.Atoi(hit.ObjectID); err == nil && h.stories != nil {
+				h.stories[id] = hit
+			}
 		} else {
 			m.Type = "comment"
 			m.Title = fmt.Sprintf("Comment on: %s", hit.StoryTitle)
 			m.Content = hit.CommentText
 			m.URL = fmt.Sprintf("https://news.ycombinator.com/item?id=%s", hit.ObjectID)
+			h.addThreadContext(ctx, &m, hit)
 		}
 
 		mentions = append(mentions, m)
@@ -113,3 +139,98 @@ func (h *HackerNews) search(ctx context.Context, keyword, tag string) ([]models.
 
 	return mentions, nil
 }
+
+// addThreadContext links a comment to its story and, optionally, to the text it replies to
+func (h *HackerNews) addThreadContext(ctx context.Context, m *models.Mention, hit hnHit) {
+	if hit.StoryID == 0 {
+		return
+	}
+
+	m.ThreadID = fmt.Sprintf("hn_%d", hit.StoryID)
+	m.ThreadURL = hit.StoryURL
+	if m.ThreadURL == "" {
+		m.ThreadURL = fmt.Sprintf("https://news.ycombinator.com/item?id=%d", hit.StoryID)
+	}
+
+	// Score and comment count describe the story, which is what the comment's reach depends on
+	if story, err := h.story(ctx, hit.StoryID); err == nil {
+		m.Score = story.Points
+		m.CommentCount = story.NumComments
+	}
+
+	if hit.ParentID == 0 {
+		return
+	}
+	m.ParentID = fmt.Sprintf("hn_%d", hit.ParentID)
+
+	// Top-level comments reply to the story itself, whose title is already known
+	if !h.FetchParent || hit.ParentID == hit.StoryID {
+		return
+	}
+	if parent, err := h.item(ctx, hit.ParentID); err == nil {
+		m.ParentContent = truncate(htmlToText(parent.Text), 500)
+	}
+}
+
+// story returns points and comment count of a story, cached per run
+func (h *HackerNews) story(ctx context.Context, id int) (hnHit, error) {
+	if story, ok := h.stories[id]; ok {
+		return story, nil
+	}
+
+	apiURL := fmt.Sprintf("https://hn.algolia.com/api/v1/search?tags=story_%d", id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return hnHit{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return hnHit{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return hnHit{}, fmt.Errorf("hn algolia returned status %d", resp.StatusCode)
+	}
+
+	var result hnSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return hnHit{}, err
+	}
+	if len(result.Hits) == 0 {
+		return hnHit{}, fmt.Errorf("hn story %d not found", id)
+	}
+
+	if h.stories != nil {
+		h.stories[id] = result.Hits[0]
+	}
+	return result.Hits[0], nil
+}
+
+// item fetches a single item from the official Hacker News API
+func (h *HackerNews) item(ctx context.Context, id int) (hnItem, error) {
+	apiURL := fmt.Sprintf("https://hacker-news.firebaseio.com/v0/item/%d.json", id)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return hnItem{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return hnItem{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return hnItem{}, fmt.Errorf("hn api returned status %d", resp.StatusCode)
+	}
+
+	var item hnItem
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return hnItem{}, err
+	}
+	return item, nil
+}
@@ -0,0 +1,163 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Hashnode collects mentions from Hashnode publications via the GraphQL API
+type Hashnode struct {
+	// Publications are publication hosts (e.g., "engineering.hashnode.com")
+	Publications []string
+}
+
+const hashnodeEndpoint = "https://gql.hashnode.com"
+
+const hashnodePublicationQuery = `query Publication($host: String!) {
+  publication(host: $host) { id title }
+}`
+
+const hashnodeSearchQuery = `query Search($publicationId: ObjectId!, $query: String!) {
+  searchPostsOfPublication(first: 20, filter: { publicationId: $publicationId, query: $query }) {
+    edges { node { id title brief url publishedAt author { username } content { text } } }
+  }
+}`
+
+type hashnodeRequest struct {
+	Query     string            `json:"query"`
+	Variables map[string]string `json:"variables"`
+}
+
+type hashnodePublicationResponse struct {
+	Data struct {
+		Publication *struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"publication"`
+	} `json:"data"`
+	Errors []hashnodeError `json:"errors"`
+}
+
+type hashnodeSearchResponse struct {
+	Data struct {
+		SearchPostsOfPublication struct {
+			Edges []struct {
+				Node hashnodePost `json:"node"`
+			} `json:"edges"`
+		} `json:"searchPostsOfPublication"`
+	} `json:"data"`
+	Errors []hashnodeError `json:"errors"`
+}
+
+type hashnodePost struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Brief       string    `json:"brief"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"publishedAt"`
+	Author      struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	Content struct {
+		Text string `json:"text"`
+	} `json:"content"`
+}
+
+type hashnodeError struct {
+	Message string `json:"message"`
+}
+
+func (h *Hashnode) Name() string { return "hashnode" }
+
+func (h *Hashnode) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, host := range h.Publications {
+		var pub hashnodePublicationResponse
+		err := h.query(ctx, hashnodePublicationQuery, map[string]string{"host": host}, &pub)
+		if err != nil || len(pub.Errors) > 0 || pub.Data.Publication == nil {
+			continue
+		}
+
+		for _, kw := range keywords {
+			results, err := h.search(ctx, pub.Data.Publication.ID, pub.Data.Publication.Title, kw)
+			if err != nil {
+				continue
+			}
+			mentions = append(mentions, results...)
+		}
+	}
+
+	return mentions, nil
+}
+
+func (h *Hashnode) search(ctx context.Context, publicationID, publication, keyword string) ([]models.Mention, error) {
+	var result hashnodeSearchResponse
+	vars := map[string]string{"publicationId": publicationID, "query": keyword}
+	if err := h.query(ctx, hashnodeSearchQuery, vars, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("hashnode error: %s", result.Errors[0].Message)
+	}
+
+	var mentions []models.Mention
+	for _, edge := range result.Data.SearchPostsOfPublication.Edges {
+		post := edge.Node
+
+		// Search results are fuzzy, so check the full text
+		text := post.Title + " " + post.Content.Text
+		found, matchedKw := ContainsKeyword(text, []string{keyword})
+		if !found {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("hashnode_%s", post.ID),
+			Source:       "hashnode",
+			Type:         "article",
+			Keyword:      matchedKw,
+			Title:        post.Title,
+			Content:      truncate(post.Brief, 500),
+			URL:          post.URL,
+			Author:       post.Author.Username,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  post.PublishedAt,
+			Community:    publication,
+		})
+	}
+
+	return mentions, nil
+}
+
+func (h *Hashnode) query(ctx context.Context, query string, vars map[string]string, out interface{}) error {
+	payload, err := json.Marshal(hashnodeRequest{Query: query, Variables: vars})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", hashnodeEndpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("hashnode returned status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
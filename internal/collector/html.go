@@ -0,0 +1,22 @@
+package collector
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// htmlToText extracts readable text from an HTML fragment, collapsing whitespace
+func htmlToText(html string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return html
+	}
+
+	// Keep block elements from running together
+	doc.Find("br, p, div, li, h1, h2, h3, h4, h5, h6, pre, blockquote").Each(func(i int, s *goquery.Selection) {
+		s.AppendHtml(" ")
+	})
+
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
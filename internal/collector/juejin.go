@@ -0,0 +1,145 @@
+package collector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Juejin collects mentions from Juejin (掘金) via the search API
+type Juejin struct{}
+
+type juejinSearchRequest struct {
+	KeyWord    string `json:"key_word"`
+	IDType     int    `json:"id_type"`
+	Cursor     string `json:"cursor"`
+	Limit      int    `json:"limit"`
+	SearchType int    `json:"search_type"`
+	SortType   int    `json:"sort_type"`
+}
+
+type juejinSearchResponse struct {
+	ErrNo  int            `json:"err_no"`
+	ErrMsg string         `json:"err_msg"`
+	Data   []juejinResult `json:"data"`
+}
+
+type juejinResult struct {
+	ResultModel juejinResultModel `json:"result_model"`
+}
+
+type juejinResultModel struct {
+	ArticleID   string            `json:"article_id"`
+	ArticleInfo juejinArticleInfo `json:"article_info"`
+	AuthorInfo  juejinUser        `json:"author_user_info"`
+}
+
+type juejinArticleInfo struct {
+	Title        string `json:"title"`
+	BriefContent string `json:"brief_content"`
+	Ctime        string `json:"ctime"`
+	DiggCount    int    `json:"digg_count"`
+	CommentCount int    `json:"comment_count"`
+}
+
+type juejinUser struct {
+	UserName string `json:"user_name"`
+}
+
+func (j *Juejin) Name() string { return "juejin" }
+
+func (j *Juejin) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, kw := range keywords {
+		results, err := j.search(ctx, kw)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+func (j *Juejin) search(ctx context.Context, keyword string) ([]models.Mention, error) {
+	// id_type 2 = articles, sort_type 2 = newest first
+	payload, err := json.Marshal(juejinSearchRequest{
+		KeyWord:  keyword,
+		IDType:   2,
+		Cursor:   "0",
+		Limit:    20,
+		SortType: 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.juejin.cn/search_api/v1/search", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("juejin returned status %d", resp.StatusCode)
+	}
+
+	var result juejinSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.ErrNo != 0 {
+		return nil, fmt.Errorf("juejin error %d: %s", result.ErrNo, result.ErrMsg)
+	}
+
+	var mentions []models.Mention
+	for _, r := range result.Data {
+		a := r.ResultModel
+		if a.ArticleID == "" {
+			continue
+		}
+
+		// Search is fuzzy, so verify the keyword actually appears
+		text := a.ArticleInfo.Title + " " + a.ArticleInfo.BriefContent
+		found, matchedKw := ContainsKeyword(text, []string{keyword})
+		if !found {
+			continue
+		}
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("juejin_%s", a.ArticleID),
+			Source:       "juejin",
+			Type:         "article",
+			Keyword:      matchedKw,
+			Title:        a.ArticleInfo.Title,
+			Content:      truncate(a.ArticleInfo.BriefContent, 500),
+			URL:          fmt.Sprintf("https://juejin.cn/post/%s", a.ArticleID),
+			Author:       a.AuthorInfo.UserName,
+			DiscoveredAt: time.Now().UTC(),
+			Score:        a.ArticleInfo.DiggCount,
+			CommentCount: a.ArticleInfo.CommentCount,
+		}
+
+		if ctime, err := strconv.ParseInt(a.ArticleInfo.Ctime, 10, 64); err == nil {
+			m.PublishedAt = time.Unix(ctime, 0).UTC()
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
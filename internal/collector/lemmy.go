@@ -0,0 +1,189 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Lemmy collects mentions from Lemmy instances via the search API
+type Lemmy struct {
+	// Instances are Lemmy hostnames (e.g., "lemmy.world")
+	Instances []string
+}
+
+// DefaultLemmyInstances are large instances that federate with most of the network
+var DefaultLemmyInstances = []string{
+	"lemmy.world",
+	"programming.dev",
+}
+
+type lemmySearchResponse struct {
+	Posts    []lemmyPostView    `json:"posts"`
+	Comments []lemmyCommentView `json:"comments"`
+}
+
+type lemmyPostView struct {
+	Post      lemmyPost      `json:"post"`
+	Creator   lemmyPerson    `json:"creator"`
+	Community lemmyCommunity `json:"community"`
+	Counts    lemmyCounts    `json:"counts"`
+}
+
+type lemmyCommentView struct {
+	Comment   lemmyComment   `json:"comment"`
+	Creator   lemmyPerson    `json:"creator"`
+	Post      lemmyPost      `json:"post"`
+	Community lemmyCommunity `json:"community"`
+	Counts    lemmyCounts    `json:"counts"`
+}
+
+type lemmyPost struct {
+	Name      string `json:"name"`
+	Body      string `json:"body"`
+	APID      string `json:"ap_id"`
+	Published string `json:"published"`
+}
+
+type lemmyComment struct {
+	Content   string `json:"content"`
+	APID      string `json:"ap_id"`
+	Published string `json:"published"`
+}
+
+type lemmyPerson struct {
+	Name    string `json:"name"`
+	ActorID string `json:"actor_id"`
+}
+
+type lemmyCommunity struct {
+	Name    string `json:"name"`
+	ActorID string `json:"actor_id"`
+}
+
+type lemmyCounts struct {
+	Score      int `json:"score"`
+	Comments   int `json:"comments"`
+	ChildCount int `json:"child_count"`
+}
+
+func (l *Lemmy) Name() string { return "lemmy" }
+
+func (l *Lemmy) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	instances := l.Instances
+	if len(instances) == 0 {
+		instances = DefaultLemmyInstances
+	}
+
+	// The same post federates to many instances; its ActivityPub ID is the same everywhere
+	seen := make(map[string]bool)
+	var mentions []models.Mention
+
+	for _, instance := range instances {
+		for _, kw := range keywords {
+			results, err := l.search(ctx, instance, kw)
+			if err != nil {
+				continue
+			}
+			for _, m := range results {
+				if seen[m.ID] {
+					continue
+				}
+				seen[m.ID] = true
+				mentions = append(mentions, m)
+			}
+		}
+	}
+
+	return mentions, nil
+}
+
+func (l *Lemmy) search(ctx context.Context, instance, keyword string) ([]models.Mention, error) {
+	apiURL := fmt.Sprintf("https://%s/api/v3/search?q=%s&type_=All&sort=New&listing_type=All&limit=50", instance, url.QueryEscape(keyword))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("lemmy %s returned status %d", instance, resp.StatusCode)
+	}
+
+	var result lemmySearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, pv := range result.Posts {
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("lemmy_%s", pv.Post.APID),
+			Source:       "lemmy",
+			Type:         "post",
+			Keyword:      keyword,
+			Title:        pv.Post.Name,
+			Content:      truncate(pv.Post.Body, 500),
+			URL:          pv.Post.APID,
+			Author:       lemmyQualifiedName(pv.Creator.Name, pv.Creator.ActorID),
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  parseLemmyTime(pv.Post.Published),
+			Community:    lemmyQualifiedName(pv.Community.Name, pv.Community.ActorID),
+			Score:        pv.Counts.Score,
+			CommentCount: pv.Counts.Comments,
+		})
+	}
+
+	for _, cv := range result.Comments {
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("lemmy_%s", cv.Comment.APID),
+			Source:       "lemmy",
+			Type:         "comment",
+			Keyword:      keyword,
+			Title:        fmt.Sprintf("Comment on: %s", cv.Post.Name),
+			Content:      truncate(cv.Comment.Content, 500),
+			URL:          cv.Comment.APID,
+			Author:       lemmyQualifiedName(cv.Creator.Name, cv.Creator.ActorID),
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  parseLemmyTime(cv.Comment.Published),
+			Community:    lemmyQualifiedName(cv.Community.Name, cv.Community.ActorID),
+			Score:        cv.Counts.Score,
+			CommentCount: cv.Counts.ChildCount,
+		})
+	}
+
+	return mentions, nil
+}
+
+// lemmyQualifiedName formats a name as name@host using its actor ID
+func lemmyQualifiedName(name, actorID string) string {
+	u, err := url.Parse(actorID)
+	if err != nil || u.Host == "" {
+		return name
+	}
+	return name + "@" + u.Host
+}
+
+// parseLemmyTime handles both RFC 3339 and the zone-less timestamps of older Lemmy versions
+func parseLemmyTime(s string) time.Time {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05.999999", strings.TrimSuffix(s, "Z")); err == nil {
+		return t.UTC()
+	}
+	return time.Time{}
+}
@@ -0,0 +1,372 @@
+package collector
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// MailingList collects mentions from mailing list archives (mbox files or public-inbox Atom feeds)
+type MailingList struct {
+	Archives []MailArchive
+}
+
+// MailArchive is a mailing list archive
+type MailArchive struct {
+	// Name is the list name (e.g., "pgsql-general")
+	Name string
+	// URL is an mbox file (local path or URL) or a public-inbox Atom feed (".../new.atom")
+	URL string
+	// MessageURL links to a message by ID, e.g. "https://www.postgresql.org/message-id/{id}" (mbox only)
+	MessageURL string
+}
+
+// PublicInbox reports whether the archive is a public-inbox Atom feed
+func (a MailArchive) PublicInbox() bool {
+	return strings.HasSuffix(a.URL, ".atom")
+}
+
+// mailMessage is a parsed message with the parts we need
+type mailMessage struct {
+	ID        string
+	InReplyTo string
+	Refs      []string
+	Subject   string
+	From      string
+	Date      time.Time
+	Body      string
+	URL       string
+}
+
+func (l *MailingList) Name() string { return "mailinglist" }
+
+func (l *MailingList) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, archive := range l.Archives {
+		var messages []mailMessage
+		var err error
+		if archive.PublicInbox() {
+			messages, err = l.fetchPublicInbox(ctx, archive.URL)
+		} else {
+			messages, err = l.fetchMbox(ctx, archive)
+		}
+		if err != nil {
+			continue
+		}
+
+		mentions = append(mentions, l.match(archive, messages, keywords)...)
+	}
+
+	return mentions, nil
+}
+
+func (l *MailingList) match(archive MailArchive, messages []mailMessage, keywords []string) []models.Mention {
+	// Only report recent messages; archives contain the full history
+	since := time.Now().Add(-24 * time.Hour)
+	roots := threadRoots(messages)
+
+	var mentions []models.Mention
+	for _, msg := range messages {
+		if msg.ID == "" || msg.Date.Before(since) {
+			continue
+		}
+
+		found, matchedKw := ContainsKeyword(msg.Subject+" "+msg.Body, keywords)
+		if !found {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("mail_%s", msg.ID),
+			Source:       "mailinglist",
+			Type:         "email",
+			Keyword:      matchedKw,
+			Title:        msg.Subject,
+			Content:      truncate(msg.Body, 500),
+			URL:          msg.URL,
+			Author:       msg.From,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  msg.Date,
+			Community:    archive.Name,
+			ThreadID:     roots[msg.ID],
+			ParentID:     msg.InReplyTo,
+		})
+	}
+
+	return mentions
+}
+
+// threadRoots maps each Message-ID to the first message of its thread, using
+// References when present and otherwise following In-Reply-To
+func threadRoots(messages []mailMessage) map[string]string {
+	parents := make(map[string]string)
+	for _, msg := range messages {
+		parents[msg.ID] = msg.InReplyTo
+	}
+
+	roots := make(map[string]string)
+	for _, msg := range messages {
+		if len(msg.Refs) > 0 {
+			roots[msg.ID] = msg.Refs[0]
+			continue
+		}
+
+		// A parent missing from this archive is still the closest root we know
+		root := msg.ID
+		for depth := 0; depth < 100 && parents[root] != ""; depth++ {
+			root = parents[root]
+		}
+		roots[msg.ID] = root
+	}
+
+	return roots
+}
+
+func (l *MailingList) fetchMbox(ctx context.Context, archive MailArchive) ([]mailMessage, error) {
+	var r io.ReadCloser
+	if strings.HasPrefix(archive.URL, "http://") || strings.HasPrefix(archive.URL, "https://") {
+		body, err := fetchMailBody(ctx, archive.URL)
+		if err != nil {
+			return nil, err
+		}
+		r = body
+	} else {
+		f, err := os.Open(archive.URL)
+		if err != nil {
+			return nil, err
+		}
+		r = f
+	}
+	defer r.Close()
+
+	var messages []mailMessage
+	for _, raw := range splitMbox(r) {
+		msg, err := parseMailMessage(raw)
+		if err != nil {
+			continue
+		}
+		if archive.MessageURL != "" {
+			msg.URL = strings.ReplaceAll(archive.MessageURL, "{id}", url.PathEscape(msg.ID))
+		}
+		messages = append(messages, *msg)
+	}
+
+	return messages, nil
+}
+
+func (l *MailingList) fetchPublicInbox(ctx context.Context, feedURL string) ([]mailMessage, error) {
+	feed, err := fetchFeed(ctx, feedURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []mailMessage
+	for _, item := range feed.Items {
+		// public-inbox serves the original message at {message URL}/raw
+		body, err := fetchMailBody(ctx, strings.TrimSuffix(item.Link, "/")+"/raw")
+		if err != nil {
+			continue
+		}
+		raw, err := io.ReadAll(io.LimitReader(body, 1<<20))
+		body.Close()
+		if err != nil {
+			continue
+		}
+
+		msg, err := parseMailMessage(raw)
+		if err != nil {
+			continue
+		}
+		msg.URL = item.Link
+		messages = append(messages, *msg)
+	}
+
+	return messages, nil
+}
+
+func fetchMailBody(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != 200 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("mail archive returned status %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+// splitMbox splits an mbox stream into raw messages, undoing mboxrd ">From " quoting
+func splitMbox(r io.Reader) [][]byte {
+	var messages [][]byte
+	var current bytes.Buffer
+	started := false
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "From ") {
+			if started {
+				messages = append(messages, append([]byte(nil), current.Bytes()...))
+				current.Reset()
+			}
+			started = true
+			continue
+		}
+		if !started {
+			continue
+		}
+
+		if unquoted := strings.TrimLeft(line, ">"); strings.HasPrefix(unquoted, "From ") && unquoted != line {
+			line = line[1:]
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+	}
+	if started {
+		messages = append(messages, current.Bytes())
+	}
+
+	return messages
+}
+
+var mailWordDecoder = new(mime.WordDecoder)
+
+// parseMailMessage parses an RFC 5322 message and extracts its text/plain body
+func parseMailMessage(raw []byte) (*mailMessage, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &mailMessage{
+		ID:        trimMessageID(m.Header.Get("Message-ID")),
+		InReplyTo: trimMessageID(firstField(m.Header.Get("In-Reply-To"))),
+	}
+	for _, ref := range strings.Fields(m.Header.Get("References")) {
+		msg.Refs = append(msg.Refs, trimMessageID(ref))
+	}
+
+	if subject, err := mailWordDecoder.DecodeHeader(m.Header.Get("Subject")); err == nil {
+		msg.Subject = subject
+	} else {
+		msg.Subject = m.Header.Get("Subject")
+	}
+
+	if addr, err := mail.ParseAddress(m.Header.Get("From")); err == nil {
+		msg.From = addr.Name
+		if msg.From == "" {
+			msg.From = addr.Address
+		}
+	} else {
+		msg.From = m.Header.Get("From")
+	}
+
+	if date, err := m.Header.Date(); err == nil {
+		msg.Date = date.UTC()
+	}
+
+	body, err := textPart(m.Header.Get("Content-Type"), m.Header.Get("Content-Transfer-Encoding"), m.Body)
+	if err != nil {
+		return nil, err
+	}
+	msg.Body = strings.TrimSpace(body)
+
+	return msg, nil
+}
+
+// textPart returns the first text/plain part of a (possibly multipart) MIME body
+func textPart(contentType, encoding string, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// Missing or broken Content-Type means plain text
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				return "", nil
+			}
+			if err != nil {
+				return "", err
+			}
+
+			text, err := textPart(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err == nil && text != "" {
+				return text, nil
+			}
+		}
+	}
+
+	if mediaType != "text/plain" {
+		return "", nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, newlineStripper{body})
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// newlineStripper drops line breaks so base64 bodies can be decoded
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (n newlineStripper) Read(p []byte) (int, error) {
+	count, err := n.r.Read(p)
+	kept := 0
+	for _, b := range p[:count] {
+		if b != '\r' && b != '\n' {
+			p[kept] = b
+			kept++
+		}
+	}
+	return kept, err
+}
+
+func trimMessageID(id string) string {
+	return strings.Trim(strings.TrimSpace(id), "<>")
+}
+
+func firstField(s string) string {
+	if fields := strings.Fields(s); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
@@ -3,11 +3,8 @@ package collector
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"net/url"
-	"time"
 
-	"github.com/mmcdole/gofeed"
 	"github.com/rebelice/mention-monitor/internal/models"
 )
 
@@ -34,24 +31,7 @@ func (m *Medium) search(ctx context.Context, keyword string) ([]models.Mention,
 	// Medium's tag-based RSS feed
 	feedURL := fmt.Sprintf("https://medium.com/feed/tag/%s", url.QueryEscape(keyword))
 
-	req, err := http.NewRequestWithContext(ctx, "GET", feedURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("User-Agent", "mention-monitor/1.0")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("medium returned status %d", resp.StatusCode)
-	}
-
-	fp := gofeed.NewParser()
-	feed, err := fp.Parse(resp.Body)
+	feed, err := fetchFeed(ctx, feedURL)
 	if err != nil {
 		return nil, err
 	}
@@ -60,28 +40,8 @@ func (m *Medium) search(ctx context.Context, keyword string) ([]models.Mention,
 	for _, item := range feed.Items {
 		// Additional keyword check in title/content
 		text := item.Title + " " + item.Description
-		if found, matchedKw := ContainsKeyword(text, []string{keyword}); !found {
-			continue
-		} else {
-			mention := models.Mention{
-				ID:           fmt.Sprintf("medium_%s", item.GUID),
-				Source:       "medium",
-				Type:         "article",
-				Keyword:      matchedKw,
-				Title:        item.Title,
-				Content:      truncate(item.Description, 500),
-				URL:          item.Link,
-				DiscoveredAt: time.Now().UTC(),
-			}
-
-			if item.Author != nil {
-				mention.Author = item.Author.Name
-			}
-			if item.PublishedParsed != nil {
-				mention.PublishedAt = *item.PublishedParsed
-			}
-
-			mentions = append(mentions, mention)
+		if found, matchedKw := ContainsKeyword(text, []string{keyword}); found {
+			mentions = append(mentions, feedItemMention(item, "medium", "article", matchedKw))
 		}
 	}
 
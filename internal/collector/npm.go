@@ -0,0 +1,298 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Npm collects new npm packages that depend on our packages via the registry changes feed
+type Npm struct {
+	// Packages are the npm package names to watch for new dependents
+	Packages []string
+	// State stores the last processed change sequence between runs
+	State map[string]string
+	// MaxChanges caps how many changed packages are inspected per run (default: 500)
+	MaxChanges int
+}
+
+const npmSeqKey = "npm_seq"
+
+// npmTimeBudget bounds how long a run spends on the changes feed, so fetching
+// changed packages can't use up the time the other collectors share
+const npmTimeBudget = 90 * time.Second
+
+// npmMaxBacklog is how far (in sequence numbers) the saved sequence may fall
+// behind the registry before a run skips ahead instead of catching up
+const npmMaxBacklog = 20000
+
+type npmChangesResponse struct {
+	Results []npmChange     `json:"results"`
+	LastSeq json.RawMessage `json:"last_seq"`
+}
+
+type npmChange struct {
+	Seq     json.RawMessage `json:"seq"`
+	ID      string          `json:"id"`
+	Deleted bool            `json:"deleted"`
+}
+
+type npmRegistryInfo struct {
+	UpdateSeq json.RawMessage `json:"update_seq"`
+}
+
+type npmVersion struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Description          string            `json:"description"`
+	Readme               string            `json:"readme"`
+	Author               npmPerson         `json:"author"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// npmPerson accepts both the object and the "Name <email>" string forms of author
+type npmPerson struct {
+	Name string
+}
+
+func (p *npmPerson) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if i := strings.Index(s, "<"); i >= 0 {
+			s = s[:i]
+		}
+		p.Name = strings.TrimSpace(s)
+		return nil
+	}
+	var obj struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil // Ignore malformed author fields
+	}
+	p.Name = obj.Name
+	return nil
+}
+
+func (n *Npm) Name() string { return "npm" }
+
+func (n *Npm) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	if len(n.Packages) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, npmTimeBudget)
+	defer cancel()
+
+	since := ""
+	if n.State != nil {
+		since = n.State[npmSeqKey]
+	}
+
+	// On the first run, or when too far behind to catch up, start from the
+	// current sequence instead of replaying the registry
+	seq, err := n.currentSeq(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if since == "" || npmBacklog(since, seq) > npmMaxBacklog {
+		if n.State != nil {
+			n.State[npmSeqKey] = seq
+		}
+		return nil, nil
+	}
+
+	changes, lastSeq, err := n.changes(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	processed := ""
+	for _, c := range changes {
+		if !c.Deleted {
+			v, err := n.latest(ctx, c.ID)
+			if ctx.Err() != nil {
+				// Out of time: resume after the last processed change next run
+				lastSeq = processed
+				break
+			}
+			if err == nil {
+				mentions = append(mentions, n.match(v, keywords)...)
+			}
+		}
+		processed = rawSeq(c.Seq)
+	}
+
+	if n.State != nil && lastSeq != "" {
+		n.State[npmSeqKey] = lastSeq
+	}
+
+	return mentions, nil
+}
+
+// npmBacklog returns how many sequence numbers since is behind current, or
+// zero when either isn't numeric
+func npmBacklog(since, current string) int64 {
+	from, err := strconv.ParseInt(since, 10, 64)
+	if err != nil {
+		return 0
+	}
+	to, err := strconv.ParseInt(current, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return to - from
+}
+
+func (n *Npm) currentSeq(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://replicate.npmjs.com/registry/", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("npm replicate returned status %d", resp.StatusCode)
+	}
+
+	var info npmRegistryInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", err
+	}
+
+	return rawSeq(info.UpdateSeq), nil
+}
+
+func (n *Npm) changes(ctx context.Context, since string) ([]npmChange, string, error) {
+	limit := n.MaxChanges
+	if limit <= 0 {
+		limit = 500
+	}
+
+	apiURL := fmt.Sprintf("https://replicate.npmjs.com/registry/_changes?since=%s&limit=%d", url.QueryEscape(since), limit)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, "", fmt.Errorf("npm changes feed returned status %d", resp.StatusCode)
+	}
+
+	var result npmChangesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", err
+	}
+
+	return result.Results, rawSeq(result.LastSeq), nil
+}
+
+func (n *Npm) latest(ctx context.Context, name string) (*npmVersion, error) {
+	apiURL := fmt.Sprintf("https://registry.npmjs.org/%s/latest", url.PathEscape(name))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("npm registry returned status %d", resp.StatusCode)
+	}
+
+	var v npmVersion
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// match returns import mentions for dependencies on our packages and a release
+// mention when the README mentions a keyword
+func (n *Npm) match(v *npmVersion, keywords []string) []models.Mention {
+	var mentions []models.Mention
+	pageURL := fmt.Sprintf("https://www.npmjs.com/package/%s", v.Name)
+
+	for _, pkg := range n.Packages {
+		if v.Name == pkg {
+			continue
+		}
+		if !npmDependsOn(v, pkg) {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("npm_%s_%s", pkg, v.Name),
+			Source:       "npm",
+			Type:         "import",
+			Keyword:      pkg,
+			Title:        fmt.Sprintf("Depended on by %s", v.Name),
+			Content:      fmt.Sprintf("Package %s@%s depends on %s", v.Name, v.Version, pkg),
+			URL:          pageURL,
+			Author:       v.Author.Name,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	if found, matchedKw := ContainsKeyword(v.Readme+" "+v.Description, keywords); found {
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("npm_release_%s_%s", v.Name, v.Version),
+			Source:       "npm",
+			Type:         "release",
+			Keyword:      matchedKw,
+			Title:        fmt.Sprintf("%s@%s mentions %s", v.Name, v.Version, matchedKw),
+			Content:      truncate(v.Description, 500),
+			URL:          pageURL,
+			Author:       v.Author.Name,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	return mentions
+}
+
+func npmDependsOn(v *npmVersion, pkg string) bool {
+	for _, deps := range []map[string]string{v.Dependencies, v.DevDependencies, v.PeerDependencies, v.OptionalDependencies} {
+		if _, ok := deps[pkg]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rawSeq converts a sequence that may be a JSON number or string into a plain string
+func rawSeq(raw json.RawMessage) string {
+	return strings.Trim(string(raw), `"`)
+}
@@ -0,0 +1,241 @@
+package collector
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// PageWatch collects mentions from arbitrary web pages by watching for changes
+// around keyword occurrences
+type PageWatch struct {
+	Pages []WatchedPage
+	// State stores content hashes and the last seen keyword sections between runs
+	State map[string]string
+}
+
+// WatchedPage is a page to watch, optionally narrowed to a CSS selector
+type WatchedPage struct {
+	URL      string
+	Selector string
+}
+
+// pageWatchContext is the number of lines kept around each keyword line
+const pageWatchContext = 2
+
+func (p *PageWatch) Name() string { return "pagewatch" }
+
+func (p *PageWatch) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, page := range p.Pages {
+		m, changed, err := p.check(ctx, page, keywords)
+		if err != nil || !changed {
+			continue
+		}
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
+
+func (p *PageWatch) check(ctx context.Context, page WatchedPage, keywords []string) (models.Mention, bool, error) {
+	title, lines, err := p.fetch(ctx, page)
+	if err != nil {
+		return models.Mention{}, false, err
+	}
+
+	key := "pagewatch_" + shortHash(page.URL+"#"+page.Selector)
+	contentHash := shortHash(strings.Join(lines, "\n"))
+
+	if p.State == nil {
+		return models.Mention{}, false, nil
+	}
+
+	// Nothing on the page changed, so the keyword sections can't have either
+	previousHash, seeded := p.State[key+"_hash"]
+	if previousHash == contentHash {
+		return models.Mention{}, false, nil
+	}
+	p.State[key+"_hash"] = contentHash
+
+	section, matchedKw := keywordSection(lines, keywords)
+	previous := p.State[key]
+	p.State[key] = strings.Join(section, "\n")
+
+	// First check of a page: record what is there without reporting it
+	if !seeded {
+		return models.Mention{}, false, nil
+	}
+
+	if strings.Join(section, "\n") == previous {
+		return models.Mention{}, false, nil
+	}
+	if len(section) == 0 && previous == "" {
+		return models.Mention{}, false, nil
+	}
+
+	var oldLines []string
+	if previous != "" {
+		oldLines = strings.Split(previous, "\n")
+	}
+
+	if matchedKw == "" {
+		// The keyword disappeared; report which keyword it was
+		_, matchedKw = ContainsKeyword(previous, keywords)
+	}
+
+	summary := "Keyword section changed"
+	if len(oldLines) == 0 {
+		summary = "Keyword appeared"
+	} else if len(section) == 0 {
+		summary = "Keyword removed"
+	}
+
+	if title == "" {
+		title = page.URL
+	}
+
+	return models.Mention{
+		ID:           fmt.Sprintf("pagewatch_%s_%s", shortHash(page.URL+"#"+page.Selector), shortHash(previous+"\x00"+strings.Join(section, "\n"))),
+		Source:       "pagewatch",
+		Type:         "change",
+		Keyword:      matchedKw,
+		Title:        fmt.Sprintf("%s on %s", summary, title),
+		Content:      truncate(lineDiff(oldLines, section), 500),
+		URL:          page.URL,
+		DiscoveredAt: time.Now().UTC(),
+	}, true, nil
+}
+
+// fetch returns the page title and the non-empty text lines of the watched region
+func (p *PageWatch) fetch(ctx context.Context, page WatchedPage) (string, []string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", page.URL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", nil, fmt.Errorf("%s returned status %d", page.URL, resp.StatusCode)
+	}
+
+	// Plain text pages (e.g. raw Markdown) are used as-is
+	if !strings.Contains(resp.Header.Get("Content-Type"), "html") {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 5<<20))
+		if err != nil {
+			return "", nil, err
+		}
+		return "", textLines(string(body)), nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return "", nil, err
+	}
+
+	selection := doc.Selection
+	if page.Selector != "" {
+		selection = doc.Find(page.Selector)
+	}
+
+	selection.Find("script, style, noscript").Remove()
+
+	// Put block elements on their own lines so sections diff cleanly
+	selection.Find("br, p, div, li, tr, h1, h2, h3, h4, h5, h6, pre, blockquote").Each(func(i int, s *goquery.Selection) {
+		s.AppendHtml("\n")
+	})
+
+	return strings.TrimSpace(doc.Find("title").First().Text()), textLines(selection.Text()), nil
+}
+
+func textLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// keywordSection returns the lines around every keyword occurrence
+func keywordSection(lines []string, keywords []string) ([]string, string) {
+	keep := make([]bool, len(lines))
+	matchedKw := ""
+	for i, line := range lines {
+		found, kw := ContainsKeyword(line, keywords)
+		if !found {
+			continue
+		}
+		if matchedKw == "" {
+			matchedKw = kw
+		}
+		for j := max(i-pageWatchContext, 0); j <= min(i+pageWatchContext, len(lines)-1); j++ {
+			keep[j] = true
+		}
+	}
+
+	var section []string
+	for i, line := range lines {
+		if keep[i] {
+			section = append(section, line)
+		}
+	}
+	return section, matchedKw
+}
+
+// lineDiff renders a minimal line diff with "+ " and "- " prefixes
+func lineDiff(oldLines, newLines []string) string {
+	// Longest common subsequence table
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []string
+	i, j := 0, 0
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j]:
+			i++
+			j++
+		case j < len(newLines) && (i == len(oldLines) || lcs[i][j+1] >= lcs[i+1][j]):
+			out = append(out, "+ "+newLines[j])
+			j++
+		default:
+			out = append(out, "- "+oldLines[i])
+			i++
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+func shortHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,154 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Podcast collects mentions from podcast RSS feeds, including published transcripts
+type Podcast struct {
+	// Feeds are podcast RSS feed URLs
+	Feeds []string
+}
+
+// Transcripts can be megabytes each, so they are only searched for the newest
+// episodes of a feed; older ones were searched in earlier runs
+const (
+	podcastTranscriptEpisodes = 5
+	podcastTranscriptMaxAge   = 14 * 24 * time.Hour
+)
+
+func (p *Podcast) Name() string { return "podcast" }
+
+func (p *Podcast) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, feedURL := range p.Feeds {
+		feed, err := fetchFeed(ctx, feedURL)
+		if err != nil {
+			continue
+		}
+
+		recent := recentEpisodes(feed.Items)
+		for _, item := range feed.Items {
+			if m, ok := p.match(ctx, feed, item, keywords, recent[item]); ok {
+				mentions = append(mentions, m)
+			}
+		}
+	}
+
+	return mentions, nil
+}
+
+func (p *Podcast) match(ctx context.Context, feed *gofeed.Feed, item *gofeed.Item, keywords []string, withTranscript bool) (models.Mention, bool) {
+	text := item.Title + " " + item.Description + " " + item.Content
+	found, matchedKw := ContainsKeyword(text, keywords)
+
+	// Search the transcript too, which also tells us where in the episode it comes up
+	var cue *transcriptCue
+	if transcriptURL, format := podcastTranscript(item); withTranscript && transcriptURL != "" {
+		if body, err := p.fetchTranscript(ctx, transcriptURL); err == nil {
+			cue = findInTranscript(body, format, keywords)
+		}
+	}
+
+	if !found && cue == nil {
+		return models.Mention{}, false
+	}
+	if !found {
+		matchedKw = cue.Keyword
+	}
+
+	m := feedItemMention(item, "podcast", "episode", matchedKw)
+	m.Community = feed.Title
+	if m.Author == "" {
+		m.Author = feed.Title
+	}
+	if cue != nil {
+		if ts := cue.Timestamp(); ts != "" {
+			m.Content = truncate(fmt.Sprintf("Mentioned at %s: %s", ts, cue.Text), 500)
+		} else {
+			m.Content = truncate(fmt.Sprintf("Mentioned in transcript: %s", cue.Text), 500)
+		}
+	}
+
+	return m, true
+}
+
+// recentEpisodes picks the newest episodes published within the max age, or the
+// first ones in feed order when episodes have no dates
+func recentEpisodes(items []*gofeed.Item) map[*gofeed.Item]bool {
+	sorted := append([]*gofeed.Item(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].PublishedParsed, sorted[j].PublishedParsed
+		return a != nil && (b == nil || a.After(*b))
+	})
+
+	recent := make(map[*gofeed.Item]bool)
+	for _, item := range sorted {
+		if len(recent) == podcastTranscriptEpisodes {
+			break
+		}
+		if item.PublishedParsed != nil && time.Since(*item.PublishedParsed) > podcastTranscriptMaxAge {
+			continue
+		}
+		recent[item] = true
+	}
+	return recent
+}
+
+func (p *Podcast) fetchTranscript(ctx context.Context, transcriptURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", transcriptURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("transcript returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 5<<20))
+	if err != nil {
+		return "", err
+	}
+
+	return string(body), nil
+}
+
+// podcastTranscript picks the most useful <podcast:transcript> of an episode,
+// preferring formats with timestamps
+func podcastTranscript(item *gofeed.Item) (string, string) {
+	preference := []string{"text/vtt", "application/x-subrip", "application/srt", "application/json", "text/plain", "text/html"}
+
+	var bestURL, bestType string
+	bestRank := len(preference)
+	for _, t := range item.Extensions["podcast"]["transcript"] {
+		rank := len(preference)
+		for i, format := range preference {
+			if strings.EqualFold(t.Attrs["type"], format) {
+				rank = i
+				break
+			}
+		}
+		if t.Attrs["url"] != "" && (bestURL == "" || rank < bestRank) {
+			bestURL, bestType, bestRank = t.Attrs["url"], t.Attrs["type"], rank
+		}
+	}
+
+	return bestURL, strings.ToLower(bestType)
+}
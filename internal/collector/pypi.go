@@ -0,0 +1,201 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// PyPI collects new PyPI releases that depend on our packages via the PyPI update feeds
+type PyPI struct {
+	// Packages are the PyPI project names to watch for new dependents
+	Packages []string
+	// State stores the newest release seen in each feed between runs
+	State map[string]string
+}
+
+// pypiFeeds are the PyPI RSS feeds checked, keyed by their state key:
+// updates.xml lists the latest releases, packages.xml the newly created projects
+var pypiFeeds = []struct{ key, url string }{
+	{"pypi_last_update", "https://pypi.org/rss/updates.xml"},
+	{"pypi_last_package", "https://pypi.org/rss/packages.xml"},
+}
+
+type pypiRelease struct {
+	Info pypiInfo `json:"info"`
+}
+
+type pypiInfo struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version"`
+	Summary      string   `json:"summary"`
+	Description  string   `json:"description"`
+	Author       string   `json:"author"`
+	RequiresDist []string `json:"requires_dist"`
+}
+
+var (
+	// pypiRequirementName extracts the project name from a PEP 508 requirement
+	pypiRequirementName = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+	pypiNameSeparators  = regexp.MustCompile(`[-_.]+`)
+)
+
+func (p *PyPI) Name() string { return "pypi" }
+
+func (p *PyPI) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	if len(p.Packages) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	var mentions []models.Mention
+
+	for _, feed := range pypiFeeds {
+		releases, err := p.recent(ctx, feed.url)
+		if err != nil || len(releases) == 0 {
+			continue
+		}
+
+		// On the first run only remember the newest release; afterwards stop
+		// at the newest release seen last time
+		last, seeded := "", false
+		if p.State != nil {
+			last, seeded = p.State[feed.key]
+			p.State[feed.key] = releases[0]
+		}
+		if !seeded {
+			continue
+		}
+
+		for _, release := range releases {
+			if release == last {
+				break
+			}
+			if seen[release] {
+				continue
+			}
+			seen[release] = true
+
+			info, err := p.release(ctx, release)
+			if err != nil {
+				continue
+			}
+			mentions = append(mentions, p.match(info, keywords)...)
+		}
+	}
+
+	return mentions, nil
+}
+
+// recent returns "name/version" paths for the releases listed in a PyPI RSS
+// feed, newest first
+func (p *PyPI) recent(ctx context.Context, feedURL string) ([]string, error) {
+	feed, err := fetchFeed(ctx, feedURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []string
+	for _, item := range feed.Items {
+		// Links look like https://pypi.org/project/{name}/{version}/
+		path := strings.TrimPrefix(item.Link, "https://pypi.org/project/")
+		path = strings.Trim(path, "/")
+		if path == "" || path == item.Link {
+			continue
+		}
+		releases = append(releases, path)
+	}
+
+	return releases, nil
+}
+
+func (p *PyPI) release(ctx context.Context, path string) (*pypiInfo, error) {
+	apiURL := fmt.Sprintf("https://pypi.org/pypi/%s/json", path)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("pypi returned status %d", resp.StatusCode)
+	}
+
+	var result pypiRelease
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Info, nil
+}
+
+func (p *PyPI) match(info *pypiInfo, keywords []string) []models.Mention {
+	var mentions []models.Mention
+	pageURL := fmt.Sprintf("https://pypi.org/project/%s/", info.Name)
+
+	for _, pkg := range p.Packages {
+		if normalizePyPIName(info.Name) == normalizePyPIName(pkg) {
+			continue
+		}
+		if !pypiRequires(info.RequiresDist, pkg) {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("pypi_%s_%s", normalizePyPIName(pkg), normalizePyPIName(info.Name)),
+			Source:       "pypi",
+			Type:         "import",
+			Keyword:      pkg,
+			Title:        fmt.Sprintf("Depended on by %s", info.Name),
+			Content:      fmt.Sprintf("Package %s %s depends on %s", info.Name, info.Version, pkg),
+			URL:          pageURL,
+			Author:       info.Author,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	if found, matchedKw := ContainsKeyword(info.Description+" "+info.Summary, keywords); found {
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("pypi_release_%s_%s", normalizePyPIName(info.Name), info.Version),
+			Source:       "pypi",
+			Type:         "release",
+			Keyword:      matchedKw,
+			Title:        fmt.Sprintf("%s %s mentions %s", info.Name, info.Version, matchedKw),
+			Content:      truncate(info.Summary, 500),
+			URL:          pageURL,
+			Author:       info.Author,
+			DiscoveredAt: time.Now().UTC(),
+		})
+	}
+
+	return mentions
+}
+
+func pypiRequires(requiresDist []string, pkg string) bool {
+	want := normalizePyPIName(pkg)
+	for _, req := range requiresDist {
+		match := pypiRequirementName.FindStringSubmatch(req)
+		if match != nil && normalizePyPIName(match[1]) == want {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizePyPIName applies PEP 503 name normalization
+func normalizePyPIName(name string) string {
+	return strings.ToLower(pypiNameSeparators.ReplaceAllString(name, "-"))
+}
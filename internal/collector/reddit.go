@@ -2,49 +2,117 @@ package collector
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/mmcdole/gofeed"
 	"github.com/rebelice/mention-monitor/internal/models"
 )
 
-// Reddit collects mentions from Reddit via RSS
-type Reddit struct{}
+// Reddit collects mentions from Reddit via RSS, or via the JSON API when
+// credentials for a "script" app are configured
+type Reddit struct {
+	ClientID     string
+	ClientSecret string
+	Username     string
+	Password     string
+	// Subreddits restricts the search to these subreddits (without "r/")
+	Subreddits []string
+	// ExcludeSubreddits drops mentions from these subreddits
+	ExcludeSubreddits []string
+
+	token string
+}
+
+type redditTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Error       string `json:"error"`
+}
+
+type redditListing struct {
+	Data struct {
+		Children []struct {
+			Data redditPost `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+type redditPost struct {
+	Name        string  `json:"name"`
+	Title       string  `json:"title"`
+	Selftext    string  `json:"selftext"`
+	Author      string  `json:"author"`
+	Subreddit   string  `json:"subreddit"`
+	Score       int     `json:"score"`
+	NumComments int     `json:"num_comments"`
+	Permalink   string  `json:"permalink"`
+	CreatedUTC  float64 `json:"created_utc"`
+}
 
 func (r *Reddit) Name() string { return "reddit" }
 
 func (r *Reddit) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	useAPI := r.ClientID != "" && r.ClientSecret != "" && r.Username != "" && r.Password != ""
+	if useAPI {
+		if err := r.authenticate(ctx); err != nil {
+			fmt.Printf("Reddit auth failed, falling back to RSS: %v\n", err)
+			useAPI = false
+		}
+	}
+
 	var mentions []models.Mention
 
 	for _, kw := range keywords {
 		// Search posts
-		posts, err := r.searchPosts(ctx, kw)
+		var posts []models.Mention
+		var err error
+		if useAPI {
+			posts, err = r.searchPostsAPI(ctx, kw)
+		} else {
+			posts, err = r.searchPosts(ctx, kw)
+		}
 		if err != nil {
 			continue
 		}
-		mentions = append(mentions, posts...)
+		mentions = append(mentions, r.filter(posts)...)
 
-		// Search comments
+		// Search comments; the API's search doesn't return comments, so this
+		// always uses the unauthenticated, rate-limited RSS feed
 		comments, err := r.searchComments(ctx, kw)
 		if err != nil {
 			continue
 		}
-		mentions = append(mentions, comments...)
+		mentions = append(mentions, r.filter(comments)...)
 	}
 
 	return mentions, nil
 }
 
+// searchPath scopes a search to the configured subreddits
+func (r *Reddit) searchPath() (string, string) {
+	if len(r.Subreddits) == 0 {
+		return "/search", ""
+	}
+	var subs []string
+	for _, sub := range r.Subreddits {
+		subs = append(subs, strings.TrimPrefix(sub, "r/"))
+	}
+	return "/r/" + strings.Join(subs, "+") + "/search", "&restrict_sr=on"
+}
+
 func (r *Reddit) searchPosts(ctx context.Context, keyword string) ([]models.Mention, error) {
-	feedURL := fmt.Sprintf("https://www.reddit.com/search.rss?q=%s&sort=new&t=day", url.QueryEscape(keyword))
+	path, restrict := r.searchPath()
+	feedURL := fmt.Sprintf("https://www.reddit.com%s.rss?q=%s&sort=new&t=day%s", path, url.QueryEscape(keyword), restrict)
 	return r.fetch(ctx, feedURL, keyword, "post")
 }
 
 func (r *Reddit) searchComments(ctx context.Context, keyword string) ([]models.Mention, error) {
-	feedURL := fmt.Sprintf("https://www.reddit.com/search.rss?q=%s&sort=new&t=day&type=comment", url.QueryEscape(keyword))
+	path, restrict := r.searchPath()
+	feedURL := fmt.Sprintf("https://www.reddit.com%s.rss?q=%s&sort=new&t=day&type=comment%s", path, url.QueryEscape(keyword), restrict)
 	return r.fetch(ctx, feedURL, keyword, "comment")
 }
 
@@ -79,7 +147,7 @@ func (r *Reddit) fetch(ctx context.Context, feedURL, keyword, contentType string
 			Type:         contentType,
 			Keyword:      keyword,
 			Title:        item.Title,
-			Content:      item.Description,
+			Content:      truncate(htmlToText(item.Description), 500),
 			URL:          item.Link,
 			DiscoveredAt: time.Now().UTC(),
 		}
@@ -91,8 +159,131 @@ func (r *Reddit) fetch(ctx context.Context, feedURL, keyword, contentType string
 			m.PublishedAt = *item.PublishedParsed
 		}
 
+		// Links look like /r/<subreddit>/comments/<post>/<slug>/[<comment>/]
+		if u, err := url.Parse(item.Link); err == nil {
+			parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+			if len(parts) >= 4 && parts[0] == "r" && parts[2] == "comments" {
+				m.Community = "r/" + parts[1]
+				m.ThreadID = "reddit_t3_" + parts[3]
+			}
+		}
+
 		mentions = append(mentions, m)
 	}
 
 	return mentions, nil
 }
+
+// authenticate obtains an access token with the password grant of a script app
+func (r *Reddit) authenticate(ctx context.Context) error {
+	form := url.Values{
+		"grant_type": {"password"},
+		"username":   {r.Username},
+		"password":   {r.Password},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://www.reddit.com/api/v1/access_token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(r.ClientID, r.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", r.userAgent())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("reddit token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var result redditTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.AccessToken == "" {
+		return fmt.Errorf("reddit token error: %s", result.Error)
+	}
+
+	r.token = result.AccessToken
+	return nil
+}
+
+func (r *Reddit) searchPostsAPI(ctx context.Context, keyword string) ([]models.Mention, error) {
+	path, restrict := r.searchPath()
+	apiURL := fmt.Sprintf("https://oauth.reddit.com%s?q=%s&sort=new&t=day&type=link&limit=100&raw_json=1%s", path, url.QueryEscape(keyword), restrict)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	req.Header.Set("User-Agent", r.userAgent())
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("reddit api returned status %d", resp.StatusCode)
+	}
+
+	var listing redditListing
+	if err := json.NewDecoder(resp.Body).Decode(&listing); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, child := range listing.Data.Children {
+		p := child.Data
+		id := fmt.Sprintf("reddit_%s", p.Name)
+		mentions = append(mentions, models.Mention{
+			ID:           id,
+			Source:       "reddit",
+			Type:         "post",
+			Keyword:      keyword,
+			Title:        p.Title,
+			Content:      truncate(p.Selftext, 500),
+			URL:          "https://www.reddit.com" + p.Permalink,
+			Author:       "/u/" + p.Author,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  time.Unix(int64(p.CreatedUTC), 0).UTC(),
+			Community:    "r/" + p.Subreddit,
+			Score:        p.Score,
+			CommentCount: p.NumComments,
+			ThreadID:     id,
+		})
+	}
+
+	return mentions, nil
+}
+
+// filter drops mentions from excluded subreddits
+func (r *Reddit) filter(mentions []models.Mention) []models.Mention {
+	if len(r.ExcludeSubreddits) == 0 {
+		return mentions
+	}
+
+	excluded := make(map[string]bool)
+	for _, sub := range r.ExcludeSubreddits {
+		excluded["r/"+strings.ToLower(strings.TrimPrefix(sub, "r/"))] = true
+	}
+
+	var kept []models.Mention
+	for _, m := range mentions {
+		if !excluded[strings.ToLower(m.Community)] {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// userAgent follows Reddit's API rules, which ask for the app's owner
+func (r *Reddit) userAgent() string {
+	return fmt.Sprintf("mention-monitor/1.0 (by /u/%s)", r.Username)
+}
@@ -0,0 +1,30 @@
+package collector
+
+import (
+	"context"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// SegmentFault collects mentions from SegmentFault (思否) via RSS
+type SegmentFault struct{}
+
+func (s *SegmentFault) Name() string { return "segmentfault" }
+
+func (s *SegmentFault) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	// SegmentFault's search is not available as a feed, so we filter the latest articles
+	feed, err := fetchFeed(ctx, "https://segmentfault.com/feeds")
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, item := range feed.Items {
+		text := item.Title + " " + item.Description + " " + item.Content
+		if found, matchedKw := ContainsKeyword(text, keywords); found {
+			mentions = append(mentions, feedItemMention(item, "segmentfault", "article", matchedKw))
+		}
+	}
+
+	return mentions, nil
+}
@@ -0,0 +1,144 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Substack collects mentions from Substack publication feeds and Substack-wide search
+type Substack struct {
+	// Publications are subdomains ("example") or custom domains ("newsletter.example.com")
+	Publications []string
+}
+
+type substackSearchResponse struct {
+	Results []substackPost `json:"results"`
+}
+
+type substackPost struct {
+	ID                int              `json:"id"`
+	Title             string           `json:"title"`
+	Subtitle          string           `json:"subtitle"`
+	TruncatedBodyText string           `json:"truncated_body_text"`
+	CanonicalURL      string           `json:"canonical_url"`
+	PostDate          time.Time        `json:"post_date"`
+	Bylines           []substackByline `json:"publishedBylines"`
+}
+
+type substackByline struct {
+	Name string `json:"name"`
+}
+
+func (s *Substack) Name() string { return "substack" }
+
+func (s *Substack) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, pub := range s.Publications {
+		results, err := s.publication(ctx, pub, keywords)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	for _, kw := range keywords {
+		results, err := s.search(ctx, kw)
+		if err != nil {
+			continue
+		}
+		mentions = append(mentions, results...)
+	}
+
+	return mentions, nil
+}
+
+// SubstackFeedURL returns the feed of a publication name or custom domain
+func SubstackFeedURL(pub string) string {
+	host := pub
+	if !strings.Contains(pub, ".") {
+		host = pub + ".substack.com"
+	}
+	return fmt.Sprintf("https://%s/feed", host)
+}
+
+// publication checks the full text of a publication's feed, which includes post bodies
+func (s *Substack) publication(ctx context.Context, pub string, keywords []string) ([]models.Mention, error) {
+	feed, err := fetchFeed(ctx, SubstackFeedURL(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, item := range feed.Items {
+		text := item.Title + " " + item.Description + " " + htmlToText(item.Content)
+		if found, matchedKw := ContainsKeyword(text, keywords); found {
+			m := feedItemMention(item, "substack", "article", matchedKw)
+			m.Community = feed.Title
+			mentions = append(mentions, m)
+		}
+	}
+
+	return mentions, nil
+}
+
+func (s *Substack) search(ctx context.Context, keyword string) ([]models.Mention, error) {
+	apiURL := fmt.Sprintf("https://substack.com/api/v1/post/search?query=%s&page=0", url.QueryEscape(keyword))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("substack returned status %d", resp.StatusCode)
+	}
+
+	var result substackSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var mentions []models.Mention
+	for _, p := range result.Results {
+		text := p.Title + " " + p.Subtitle + " " + p.TruncatedBodyText
+		found, matchedKw := ContainsKeyword(text, []string{keyword})
+		if !found {
+			continue
+		}
+
+		m := models.Mention{
+			ID:           fmt.Sprintf("substack_%d", p.ID),
+			Source:       "substack",
+			Type:         "article",
+			Keyword:      matchedKw,
+			Title:        p.Title,
+			Content:      truncate(p.TruncatedBodyText, 500),
+			URL:          p.CanonicalURL,
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  p.PostDate,
+		}
+
+		if len(p.Bylines) > 0 {
+			m.Author = p.Bylines[0].Name
+		}
+
+		mentions = append(mentions, m)
+	}
+
+	return mentions, nil
+}
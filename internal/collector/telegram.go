@@ -0,0 +1,191 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Telegram collects mentions from channels and groups a bot is a member of via getUpdates.
+// The bot must not have a webhook set, and should not be shared with the Telegram notifier
+// since confirming updates here would drop the notifier's button presses.
+type Telegram struct {
+	BotToken string
+	// Chats limits collection to these chat usernames or IDs (default: all chats)
+	Chats []string
+	// APIURL is the Bot API server (default: https://api.telegram.org)
+	APIURL string
+	// State stores the update offset between runs
+	State map[string]string
+}
+
+const telegramOffsetKey = "telegram_offset"
+
+type tgUpdatesResponse struct {
+	OK          bool       `json:"ok"`
+	Description string     `json:"description"`
+	Result      []tgUpdate `json:"result"`
+}
+
+type tgUpdate struct {
+	UpdateID    int        `json:"update_id"`
+	Message     *tgMessage `json:"message"`
+	ChannelPost *tgMessage `json:"channel_post"`
+}
+
+type tgMessage struct {
+	MessageID       int     `json:"message_id"`
+	Date            int64   `json:"date"`
+	Text            string  `json:"text"`
+	Caption         string  `json:"caption"`
+	AuthorSignature string  `json:"author_signature"`
+	From            *tgUser `json:"from"`
+	Chat            tgChat  `json:"chat"`
+}
+
+type tgUser struct {
+	Username  string `json:"username"`
+	FirstName string `json:"first_name"`
+}
+
+type tgChat struct {
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	Username string `json:"username"`
+}
+
+func (t *Telegram) Name() string { return "telegram" }
+
+func (t *Telegram) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	if t.BotToken == "" {
+		return nil, nil
+	}
+
+	apiURL := t.APIURL
+	if apiURL == "" {
+		apiURL = "https://api.telegram.org"
+	}
+
+	params := url.Values{}
+	params.Set("allowed_updates", `["message","channel_post"]`)
+	params.Set("timeout", "0")
+	if t.State != nil && t.State[telegramOffsetKey] != "" {
+		params.Set("offset", t.State[telegramOffsetKey])
+	}
+
+	reqURL := fmt.Sprintf("%s/bot%s/getUpdates?%s", strings.TrimSuffix(apiURL, "/"), t.BotToken, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result tgUpdatesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if !result.OK {
+		return nil, fmt.Errorf("telegram getUpdates failed: %s", result.Description)
+	}
+
+	var mentions []models.Mention
+	for _, u := range result.Result {
+		// Passing update_id+1 as the next offset confirms everything up to here
+		if t.State != nil {
+			t.State[telegramOffsetKey] = strconv.Itoa(u.UpdateID + 1)
+		}
+
+		msg := u.ChannelPost
+		if msg == nil {
+			msg = u.Message
+		}
+		if msg == nil || !t.watching(msg.Chat) {
+			continue
+		}
+
+		text := msg.Text
+		if text == "" {
+			text = msg.Caption
+		}
+		found, matchedKw := ContainsKeyword(text, keywords)
+		if !found {
+			continue
+		}
+
+		mentions = append(mentions, models.Mention{
+			ID:           fmt.Sprintf("telegram_%d_%d", msg.Chat.ID, msg.MessageID),
+			Source:       "telegram",
+			Type:         "message",
+			Keyword:      matchedKw,
+			Title:        truncate(firstLine(text), 100),
+			Content:      truncate(text, 500),
+			URL:          telegramPermalink(msg.Chat, msg.MessageID),
+			Author:       telegramAuthor(msg),
+			DiscoveredAt: time.Now().UTC(),
+			PublishedAt:  time.Unix(msg.Date, 0).UTC(),
+			Community:    msg.Chat.Title,
+		})
+	}
+
+	return mentions, nil
+}
+
+func (t *Telegram) watching(chat tgChat) bool {
+	if len(t.Chats) == 0 {
+		return true
+	}
+	for _, c := range t.Chats {
+		c = strings.TrimPrefix(c, "@")
+		if strings.EqualFold(c, chat.Username) || c == strconv.FormatInt(chat.ID, 10) {
+			return true
+		}
+	}
+	return false
+}
+
+// telegramPermalink links to a message in a public chat, or via t.me/c/ for private ones
+func telegramPermalink(chat tgChat, messageID int) string {
+	if chat.Username != "" {
+		return fmt.Sprintf("https://t.me/%s/%d", chat.Username, messageID)
+	}
+	// Private supergroup and channel IDs are "-100" followed by the internal ID;
+	// basic groups have no message links
+	internalID, ok := strings.CutPrefix(strconv.FormatInt(chat.ID, 10), "-100")
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("https://t.me/c/%s/%d", internalID, messageID)
+}
+
+func telegramAuthor(msg *tgMessage) string {
+	if msg.AuthorSignature != "" {
+		return msg.AuthorSignature
+	}
+	if msg.From != nil {
+		if msg.From.Username != "" {
+			return msg.From.Username
+		}
+		return msg.From.FirstName
+	}
+	return msg.Chat.Title
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
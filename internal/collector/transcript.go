@@ -0,0 +1,149 @@
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// transcriptCue is a transcript passage that contains a keyword
+type transcriptCue struct {
+	Start   time.Duration
+	HasTime bool
+	Text    string
+	Keyword string
+}
+
+// Timestamp formats the cue start as HH:MM:SS, or "" when the transcript has no timing
+func (c *transcriptCue) Timestamp() string {
+	if !c.HasTime {
+		return ""
+	}
+	total := int(c.Start.Seconds())
+	return fmt.Sprintf("%02d:%02d:%02d", total/3600, total/60%60, total%60)
+}
+
+type jsonTranscript struct {
+	Segments []struct {
+		StartTime float64 `json:"startTime"`
+		Body      string  `json:"body"`
+	} `json:"segments"`
+}
+
+// cueTiming matches the start of a WebVTT or SRT timing line ("00:01:02.500 --> ...")
+var cueTiming = regexp.MustCompile(`^\s*(?:(\d+):)?(\d{1,2}):(\d{2})[.,](\d{1,3})\s*-->`)
+
+// findInTranscript returns the first passage of a transcript that mentions a keyword
+func findInTranscript(body, format string, keywords []string) *transcriptCue {
+	switch format {
+	case "text/vtt", "application/x-subrip", "application/srt":
+		return findInTimedText(body, keywords)
+	case "application/json":
+		var t jsonTranscript
+		if err := json.Unmarshal([]byte(body), &t); err != nil {
+			return nil
+		}
+		for _, seg := range t.Segments {
+			if found, kw := ContainsKeyword(seg.Body, keywords); found {
+				return &transcriptCue{
+					Start:   time.Duration(seg.StartTime * float64(time.Second)),
+					HasTime: true,
+					Text:    strings.TrimSpace(seg.Body),
+					Keyword: kw,
+				}
+			}
+		}
+		return nil
+	case "text/html":
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+		if err != nil {
+			return nil
+		}
+		return findInPlainText(doc.Text(), keywords)
+	default:
+		return findInPlainText(body, keywords)
+	}
+}
+
+// findInTimedText handles WebVTT and SRT, whose cues are separated by blank lines
+func findInTimedText(body string, keywords []string) *transcriptCue {
+	var start time.Duration
+	var text []string
+	inCue := false
+
+	flush := func() *transcriptCue {
+		joined := strings.Join(text, " ")
+		text = text[:0]
+		if found, kw := ContainsKeyword(joined, keywords); found {
+			return &transcriptCue{Start: start, HasTime: true, Text: joined, Keyword: kw}
+		}
+		return nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		if match := cueTiming.FindStringSubmatch(line); match != nil {
+			if cue := flush(); cue != nil {
+				return cue
+			}
+			start = parseCueTime(match)
+			inCue = true
+			continue
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if cue := flush(); cue != nil {
+				return cue
+			}
+			inCue = false
+			continue
+		}
+		if inCue {
+			text = append(text, line)
+		}
+	}
+
+	return flush()
+}
+
+func parseCueTime(match []string) time.Duration {
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	seconds, _ := strconv.Atoi(match[3])
+	millis, _ := strconv.Atoi(match[4])
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds)*time.Second +
+		time.Duration(millis)*time.Millisecond
+}
+
+// findInPlainText returns the text surrounding the first keyword match
+func findInPlainText(body string, keywords []string) *transcriptCue {
+	found, kw := ContainsKeyword(body, keywords)
+	if !found {
+		return nil
+	}
+
+	runes := []rune(body)
+	normalized := []rune(normalizeText(body))
+	target := []rune(normalizeText(kw))
+
+	pos := 0
+	for i := 0; i+len(target) <= len(normalized); i++ {
+		if string(normalized[i:i+len(target)]) == string(target) {
+			pos = i
+			break
+		}
+	}
+
+	from := max(pos-100, 0)
+	to := min(pos+len(target)+100, len(runes))
+	snippet := strings.Join(strings.Fields(string(runes[from:to])), " ")
+
+	return &transcriptCue{Text: snippet, Keyword: kw}
+}
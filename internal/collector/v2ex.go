@@ -0,0 +1,103 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// V2EX collects mentions from V2EX via the topics API
+type V2EX struct{}
+
+type v2exTopic struct {
+	ID      int        `json:"id"`
+	Title   string     `json:"title"`
+	URL     string     `json:"url"`
+	Content string     `json:"content"`
+	Created int64      `json:"created"`
+	Replies int        `json:"replies"`
+	Member  v2exMember `json:"member"`
+	Node    v2exNode   `json:"node"`
+}
+
+type v2exMember struct {
+	Username string `json:"username"`
+}
+
+type v2exNode struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+func (v *V2EX) Name() string { return "v2ex" }
+
+func (v *V2EX) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	// V2EX has no search API, so we filter the latest and hot topics
+	seen := make(map[int]bool)
+	var mentions []models.Mention
+
+	for _, list := range []string{"latest", "hot"} {
+		topics, err := v.topics(ctx, list)
+		if err != nil {
+			continue
+		}
+
+		for _, t := range topics {
+			if seen[t.ID] {
+				continue
+			}
+			seen[t.ID] = true
+
+			text := t.Title + " " + t.Content
+			if found, matchedKw := ContainsKeyword(text, keywords); found {
+				mentions = append(mentions, models.Mention{
+					ID:           fmt.Sprintf("v2ex_%d", t.ID),
+					Source:       "v2ex",
+					Type:         "post",
+					Keyword:      matchedKw,
+					Title:        t.Title,
+					Content:      truncate(t.Content, 500),
+					URL:          t.URL,
+					Author:       t.Member.Username,
+					DiscoveredAt: time.Now().UTC(),
+					PublishedAt:  time.Unix(t.Created, 0).UTC(),
+					Community:    t.Node.Title,
+					CommentCount: t.Replies,
+				})
+			}
+		}
+	}
+
+	return mentions, nil
+}
+
+func (v *V2EX) topics(ctx context.Context, list string) ([]v2exTopic, error) {
+	apiURL := fmt.Sprintf("https://www.v2ex.com/api/topics/%s.json", list)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "mention-monitor/1.0")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("v2ex returned status %d", resp.StatusCode)
+	}
+
+	var topics []v2exTopic
+	if err := json.NewDecoder(resp.Body).Decode(&topics); err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
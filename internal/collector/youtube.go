@@ -0,0 +1,69 @@
+package collector
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// YouTube collects mentions from YouTube channel and playlist Atom feeds
+type YouTube struct {
+	// Channels are channel IDs ("UC..."), playlist IDs ("PL...") or full feed URLs
+	Channels []string
+}
+
+func (y *YouTube) Name() string { return "youtube" }
+
+func (y *YouTube) Collect(ctx context.Context, keywords []string) ([]models.Mention, error) {
+	var mentions []models.Mention
+
+	for _, channel := range y.Channels {
+		feed, err := fetchFeed(ctx, YouTubeFeedURL(channel))
+		if err != nil {
+			continue
+		}
+
+		for _, item := range feed.Items {
+			description := youTubeDescription(item)
+			text := item.Title + " " + description
+			found, matchedKw := ContainsKeyword(text, keywords)
+			if !found {
+				continue
+			}
+
+			m := feedItemMention(item, "youtube", "video", matchedKw)
+			m.Content = truncate(description, 500)
+			m.Community = feed.Title
+			mentions = append(mentions, m)
+		}
+	}
+
+	return mentions, nil
+}
+
+// YouTubeFeedURL returns the RSS feed of a channel ID, playlist ID or feed URL
+func YouTubeFeedURL(channel string) string {
+	switch {
+	case strings.HasPrefix(channel, "http://"), strings.HasPrefix(channel, "https://"):
+		return channel
+	case strings.HasPrefix(channel, "PL"), strings.HasPrefix(channel, "UU"):
+		return "https://www.youtube.com/feeds/videos.xml?playlist_id=" + url.QueryEscape(channel)
+	default:
+		return "https://www.youtube.com/feeds/videos.xml?channel_id=" + url.QueryEscape(channel)
+	}
+}
+
+// youTubeDescription reads the video description from the media:group extension
+func youTubeDescription(item *gofeed.Item) string {
+	for _, group := range item.Extensions["media"]["group"] {
+		for _, desc := range group.Children["description"] {
+			if desc.Value != "" {
+				return desc.Value
+			}
+		}
+	}
+	return item.Description
+}
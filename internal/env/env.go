@@ -0,0 +1,28 @@
+package env
+
+import (
+	"os"
+	"strings"
+)
+
+// DefaultFeedsFile is where feed configuration lives unless FEEDS_FILE is set
+const DefaultFeedsFile = "config/feeds.json"
+
+// FeedsFile returns the feed configuration path from FEEDS_FILE
+func FeedsFile() string {
+	if path := os.Getenv("FEEDS_FILE"); path != "" {
+		return path
+	}
+	return DefaultFeedsFile
+}
+
+// SplitList splits a comma-separated environment value, dropping empty entries
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
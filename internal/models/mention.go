@@ -4,20 +4,34 @@ import "time"
 
 // Mention represents a single mention of a keyword
 type Mention struct {
-	ID           string    `json:"id"`
-	Source       string    `json:"source"`        // hackernews, reddit, github, twitter, devto, medium, stackoverflow, producthunt, lobsters, pkggodev, google
-	Type         string    `json:"type"`          // post, comment, issue, discussion, article, question, answer
-	Keyword      string    `json:"keyword"`       // matched keyword
-	Title        string    `json:"title"`         // title or comment excerpt
-	Content      string    `json:"content"`       // full content
-	URL          string    `json:"url"`           // link to original
-	Author       string    `json:"author"`        // author name
-	DiscoveredAt time.Time `json:"discovered_at"` // when we found it
-	PublishedAt  time.Time `json:"published_at"`  // when it was published (if available)
+	ID            string    `json:"id"`
+	Source        string    `json:"source"`                   // hackernews, reddit, github, gitlab, gitea, bitbucket, twitter, devto, medium, stackoverflow, producthunt, lobsters, pkggodev, npm, pypi, crates, lemmy, discourse, v2ex, juejin, segmentfault, youtube, podcast, hashnode, substack, ghost, mailinglist, telegram, discord, pagewatch, awesome, google
+	Type          string    `json:"type"`                     // post, comment, topic, issue, discussion, article, question, answer, import, release, video, episode, email, message, change, added, removed
+	Keyword       string    `json:"keyword"`                  // matched keyword
+	Title         string    `json:"title"`                    // title or comment excerpt
+	Content       string    `json:"content"`                  // full content
+	URL           string    `json:"url"`                      // link to original
+	Author        string    `json:"author"`                   // author name
+	DiscoveredAt  time.Time `json:"discovered_at"`            // when we found it
+	PublishedAt   time.Time `json:"published_at"`             // when it was published (if available)
+	Community     string    `json:"community,omitempty"`      // community, subreddit or list it was posted in
+	Score         int       `json:"score,omitempty"`          // votes or points
+	CommentCount  int       `json:"comment_count,omitempty"`  // number of replies
+	ThreadID      string    `json:"thread_id,omitempty"`      // root of the thread or story it belongs to
+	ThreadURL     string    `json:"thread_url,omitempty"`     // link the thread root points to
+	ParentID      string    `json:"parent_id,omitempty"`      // item it replies to
+	ParentContent string    `json:"parent_content,omitempty"` // text of the item it replies to
 }
 
 // Data represents the stored data structure
 type Data struct {
 	LastUpdated time.Time `json:"last_updated"`
 	Mentions    []Mention `json:"mentions"`
+	// State holds per-source watermarks (e.g. registry change sequence numbers)
+	// that must survive between runs
+	State map[string]string `json:"state,omitempty"`
+	// Queue holds the IDs of mentions waiting for each notifier's scheduled
+	// digest, and LastDelivered when that notifier's last digest window closed
+	Queue         map[string][]string  `json:"queue,omitempty"`
+	LastDelivered map[string]time.Time `json:"last_delivered,omitempty"`
 }
@@ -1,10 +1,16 @@
 package notifier
 
 import (
+	"bytes"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
-	"net/url"
 	"strings"
 
 	"github.com/rebelice/mention-monitor/internal/models"
@@ -14,131 +20,180 @@ import (
 type Bark struct {
 	// ServerURL is the Bark server URL (default: https://api.day.app)
 	ServerURL string
-	// DeviceKey is your Bark device key
-	DeviceKey string
+	// DeviceKeys are your Bark device keys; each push goes to all of them
+	DeviceKeys []string
+	// Level is the interruption level: active, timeSensitive or passive
+	Level string
+	// Sound is the name of a Bark sound (e.g., "minuet")
+	Sound string
+	// GroupBy groups notifications by "source" or "keyword" instead of
+	// a single "mention-monitor" group
+	GroupBy string
+	// Badge sets the app badge to the number of mentions in the push
+	Badge bool
+	// EncryptionKey enables encrypted push with AES-CBC; it must be 16, 24 or
+	// 32 bytes and match the key configured in the Bark app
+	EncryptionKey string
+	// EncryptionIV is the 16-byte IV; a random one is sent with each push when empty
+	EncryptionIV string
+}
+
+// barkLevels are the interruption levels Bark accepts
+var barkLevels = map[string]bool{
+	"active":        true,
+	"timeSensitive": true,
+	"passive":       true,
+}
+
+type barkPush struct {
+	DeviceKey  string   `json:"device_key,omitempty"`
+	DeviceKeys []string `json:"device_keys,omitempty"`
+	Title      string   `json:"title,omitempty"`
+	Body       string   `json:"body,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	Group      string   `json:"group,omitempty"`
+	Icon       string   `json:"icon,omitempty"`
+	Level      string   `json:"level,omitempty"`
+	Sound      string   `json:"sound,omitempty"`
+	Badge      int      `json:"badge,omitempty"`
+	Ciphertext string   `json:"ciphertext,omitempty"`
+	IV         string   `json:"iv,omitempty"`
+}
+
+type barkResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 }
 
 // NewBark creates a new Bark notifier
-func NewBark(deviceKey string) *Bark {
+func NewBark(deviceKeys ...string) *Bark {
 	return &Bark{
-		ServerURL: "https://api.day.app",
-		DeviceKey: deviceKey,
+		ServerURL:  "https://api.day.app",
+		DeviceKeys: deviceKeys,
 	}
 }
 
 // NewBarkWithServer creates a new Bark notifier with custom server
-func NewBarkWithServer(serverURL, deviceKey string) *Bark {
+func NewBarkWithServer(serverURL string, deviceKeys ...string) *Bark {
 	return &Bark{
-		ServerURL: strings.TrimSuffix(serverURL, "/"),
-		DeviceKey: deviceKey,
+		ServerURL:  strings.TrimSuffix(serverURL, "/"),
+		DeviceKeys: deviceKeys,
 	}
 }
 
+func (b *Bark) Name() string { return "bark" }
+
 // Send sends a notification for each mention
 func (b *Bark) Send(ctx context.Context, mentions []models.Mention) error {
-	if b.DeviceKey == "" {
-		return fmt.Errorf("bark device key not configured")
+	if err := b.check(); err != nil {
+		return err
 	}
 
+	var lastErr error
 	for _, m := range mentions {
 		if err := b.sendOne(ctx, m); err != nil {
 			// Log error but continue with other mentions
 			fmt.Printf("Failed to send Bark notification for %s: %v\n", m.ID, err)
+			lastErr = err
 		}
 	}
 
-	return nil
+	return lastErr
 }
 
 func (b *Bark) sendOne(ctx context.Context, m models.Mention) error {
-	// Format: 详细模式
-	// 🔔 New mention on Hacker News
-	// Title: Show HN: lazypg - Terminal UI for PostgreSQL
-	// Author: someone
-
-	title := fmt.Sprintf("New mention on %s", formatSourceName(m.Source))
-	body := fmt.Sprintf("Title: %s", m.Title)
-	if m.Author != "" {
-		body += fmt.Sprintf("\nAuthor: %s", m.Author)
-	}
-
-	// Build URL with parameters
-	// Format: https://api.day.app/{key}/{title}/{body}?url={url}&group={group}
-	pushURL := fmt.Sprintf("%s/%s/%s/%s",
-		b.ServerURL,
-		b.DeviceKey,
-		url.PathEscape(title),
-		url.PathEscape(body),
-	)
-
-	// Add query parameters
-	params := url.Values{}
-	params.Set("url", m.URL)                    // Click to open original URL
-	params.Set("group", "mention-monitor")      // Group notifications
-	params.Set("icon", getSourceIcon(m.Source)) // Source icon
-
-	pushURL += "?" + params.Encode()
-
-	req, err := http.NewRequestWithContext(ctx, "GET", pushURL, nil)
-	if err != nil {
+	title, body := formatMention(m)
+	return b.push(ctx, barkPush{
+		Title: title,
+		Body:  body,
+		URL:   m.URL,                   // Click to open original URL
+		Group: b.group(m),              // Group notifications
+		Icon:  getSourceIcon(m.Source), // Source icon
+	}, 1)
+}
+
+// SendBatch sends a single aggregated notification for multiple mentions
+func (b *Bark) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if err := b.check(); err != nil {
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
+	if len(mentions) == 0 {
+		return nil
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("bark returned status %d", resp.StatusCode)
+	if len(mentions) == 1 {
+		return b.sendOne(ctx, mentions[0])
 	}
 
-	return nil
+	// Aggregated notification, grouped only when every mention shares the group
+	title, body := formatBatch(mentions)
+	group := b.group(mentions[0])
+	for _, m := range mentions[1:] {
+		if b.group(m) != group {
+			group = "mention-monitor"
+			break
+		}
+	}
+
+	return b.push(ctx, barkPush{Title: title, Body: body, Group: group}, len(mentions))
 }
 
-// SendBatch sends a single aggregated notification for multiple mentions
-func (b *Bark) SendBatch(ctx context.Context, mentions []models.Mention) error {
-	if b.DeviceKey == "" {
+func (b *Bark) check() error {
+	if len(b.DeviceKeys) == 0 {
 		return fmt.Errorf("bark device key not configured")
 	}
-
-	if len(mentions) == 0 {
-		return nil
+	if b.Level != "" && !barkLevels[b.Level] {
+		return fmt.Errorf("unknown bark level %q", b.Level)
 	}
+	return nil
+}
 
-	if len(mentions) == 1 {
-		return b.sendOne(ctx, mentions[0])
+func (b *Bark) group(m models.Mention) string {
+	switch b.GroupBy {
+	case "source":
+		return formatSourceName(m.Source)
+	case "keyword":
+		if m.Keyword != "" {
+			return m.Keyword
+		}
 	}
+	return "mention-monitor"
+}
 
-	// Aggregated notification
-	title := fmt.Sprintf("%d new mentions", len(mentions))
+// push POSTs a notification to the JSON /push API, which has no URL length
+// limit and accepts several device keys at once
+func (b *Bark) push(ctx context.Context, p barkPush, count int) error {
+	p.Level = b.Level
+	p.Sound = b.Sound
+	if b.Badge {
+		p.Badge = count
+	}
 
-	var bodyParts []string
-	for i, m := range mentions {
-		if i >= 5 {
-			bodyParts = append(bodyParts, fmt.Sprintf("... and %d more", len(mentions)-5))
-			break
+	if b.EncryptionKey != "" {
+		encrypted, err := b.encrypt(p)
+		if err != nil {
+			return err
 		}
-		bodyParts = append(bodyParts, fmt.Sprintf("• [%s] %s", m.Source, truncateString(m.Title, 50)))
+		p = encrypted
 	}
-	body := strings.Join(bodyParts, "\n")
 
-	pushURL := fmt.Sprintf("%s/%s/%s/%s",
-		b.ServerURL,
-		b.DeviceKey,
-		url.PathEscape(title),
-		url.PathEscape(body),
-	)
+	if len(b.DeviceKeys) == 1 {
+		p.DeviceKey = b.DeviceKeys[0]
+	} else {
+		p.DeviceKeys = b.DeviceKeys
+	}
 
-	params := url.Values{}
-	params.Set("group", "mention-monitor")
-	pushURL += "?" + params.Encode()
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", pushURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", b.ServerURL+"/push", bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -146,57 +201,63 @@ func (b *Bark) SendBatch(ctx context.Context, mentions []models.Mention) error {
 	}
 	defer resp.Body.Close()
 
+	var result barkResponse
+	json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&result)
+
 	if resp.StatusCode != 200 {
+		if result.Message != "" {
+			return fmt.Errorf("bark returned status %d: %s", resp.StatusCode, result.Message)
+		}
 		return fmt.Errorf("bark returned status %d", resp.StatusCode)
 	}
 
 	return nil
 }
 
-func formatSourceName(source string) string {
-	names := map[string]string{
-		"hackernews":    "Hacker News",
-		"reddit":        "Reddit",
-		"github":        "GitHub",
-		"twitter":       "Twitter",
-		"devto":         "Dev.to",
-		"medium":        "Medium",
-		"stackoverflow": "Stack Overflow",
-		"producthunt":   "Product Hunt",
-		"lobsters":      "Lobsters",
-		"pkggodev":      "pkg.go.dev",
-		"google":        "Google",
-	}
-	if name, ok := names[source]; ok {
-		return name
-	}
-	return source
-}
+// encrypt moves the notification fields into an AES-CBC (PKCS7) encrypted,
+// base64-encoded ciphertext, leaving only what the server needs in the clear
+func (b *Bark) encrypt(p barkPush) (barkPush, error) {
+	key := []byte(b.EncryptionKey)
+	if n := len(key); n != 16 && n != 24 && n != 32 {
+		return barkPush{}, fmt.Errorf("bark encryption key must be 16, 24 or 32 bytes, got %d", n)
+	}
+
+	iv := []byte(b.EncryptionIV)
+	if len(iv) == 0 {
+		iv = []byte(randomBarkIV())
+	}
+	if len(iv) != aes.BlockSize {
+		return barkPush{}, fmt.Errorf("bark encryption IV must be %d bytes, got %d", aes.BlockSize, len(iv))
+	}
+
+	plaintext, err := json.Marshal(p)
+	if err != nil {
+		return barkPush{}, err
+	}
+	padding := aes.BlockSize - len(plaintext)%aes.BlockSize
+	plaintext = append(plaintext, bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return barkPush{}, err
+	}
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
 
-func getSourceIcon(source string) string {
-	// Using emoji as icons (Bark supports custom icons via URL too)
-	icons := map[string]string{
-		"hackernews":    "https://news.ycombinator.com/favicon.ico",
-		"reddit":        "https://www.reddit.com/favicon.ico",
-		"github":        "https://github.com/favicon.ico",
-		"twitter":       "https://twitter.com/favicon.ico",
-		"devto":         "https://dev.to/favicon.ico",
-		"medium":        "https://medium.com/favicon.ico",
-		"stackoverflow": "https://stackoverflow.com/favicon.ico",
-		"producthunt":   "https://www.producthunt.com/favicon.ico",
-		"lobsters":      "https://lobste.rs/favicon.ico",
-		"pkggodev":      "https://pkg.go.dev/favicon.ico",
-		"google":        "https://www.google.com/favicon.ico",
-	}
-	if icon, ok := icons[source]; ok {
-		return icon
-	}
-	return ""
+	return barkPush{
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+		IV:         string(iv),
+	}, nil
 }
 
-func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+// randomBarkIV returns a 16-character alphanumeric IV, matching the printable
+// IVs the Bark app expects
+func randomBarkIV() string {
+	const alphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	b := make([]byte, aes.BlockSize)
+	rand.Read(b)
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
 	}
-	return s[:maxLen-3] + "..."
+	return string(b)
 }
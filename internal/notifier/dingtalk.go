@@ -0,0 +1,109 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// DingTalk posts mentions as Markdown to a DingTalk group robot
+type DingTalk struct {
+	// WebhookURL includes the robot's access_token
+	WebhookURL string
+	// Secret signs requests when the robot uses 加签 security
+	Secret string
+}
+
+// dingTalkMaxBytes keeps batched messages below DingTalk's 20000-byte limit
+const dingTalkMaxBytes = 18000
+
+type dingTalkMessage struct {
+	MsgType  string           `json:"msgtype"`
+	Markdown dingTalkMarkdown `json:"markdown"`
+}
+
+type dingTalkMarkdown struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+// NewDingTalk creates a new DingTalk group robot notifier
+func NewDingTalk(webhookURL, secret string) *DingTalk {
+	return &DingTalk{WebhookURL: webhookURL, Secret: secret}
+}
+
+func (d *DingTalk) Name() string { return "dingtalk" }
+
+// Send posts a message for each mention
+func (d *DingTalk) Send(ctx context.Context, mentions []models.Mention) error {
+	if d.WebhookURL == "" {
+		return fmt.Errorf("dingtalk webhook URL not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		title := fmt.Sprintf("New mention on %s", formatSourceName(m.Source))
+		text := fmt.Sprintf("#### %s\n\n%s", title, robotMarkdown(m))
+		if m.Content != "" {
+			text += "\n\n> " + robotEscape(truncateString(m.Content, 300))
+		}
+
+		if err := d.post(ctx, title, text); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send DingTalk message for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts the mentions as a list, split to fit DingTalk's size limit
+func (d *DingTalk) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if d.WebhookURL == "" {
+		return fmt.Errorf("dingtalk webhook URL not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	var entries []string
+	for _, m := range mentions {
+		entries = append(entries, "- "+strings.ReplaceAll(robotMarkdown(m), "\n", "\n  "))
+	}
+
+	title := fmt.Sprintf("%d new mentions", len(mentions))
+	for _, chunk := range robotChunks(entries, dingTalkMaxBytes) {
+		if err := d.post(ctx, title, fmt.Sprintf("#### %s\n\n%s", title, chunk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DingTalk) post(ctx context.Context, title, text string) error {
+	webhookURL := d.WebhookURL
+	if d.Secret != "" {
+		// DingTalk signs timestamp + "\n" + secret with the secret, in milliseconds
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		sign := robotSign(d.Secret, timestamp+"\n"+d.Secret)
+		separator := "?"
+		if strings.Contains(webhookURL, "?") {
+			separator = "&"
+		}
+		webhookURL += fmt.Sprintf("%stimestamp=%s&sign=%s", separator, timestamp, url.QueryEscape(sign))
+	}
+
+	msg := dingTalkMessage{
+		MsgType:  "markdown",
+		Markdown: dingTalkMarkdown{Title: title, Text: text},
+	}
+	return postRobot(ctx, "dingtalk", webhookURL, msg)
+}
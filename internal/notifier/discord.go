@@ -0,0 +1,272 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Discord posts mentions as embeds to Discord channel webhooks
+type Discord struct {
+	WebhookURLs []string
+}
+
+// discordMaxEmbeds is the number of embeds Discord accepts per message
+const discordMaxEmbeds = 10
+
+// discordMaxEmbedChars is Discord's limit on the combined text of a message's embeds
+const discordMaxEmbedChars = 6000
+
+// discordMaxRetries bounds how often a rate-limited message is retried
+const discordMaxRetries = 3
+
+type discordMessage struct {
+	Content string         `json:"content,omitempty"`
+	Embeds  []discordEmbed `json:"embeds,omitempty"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+	Author      *discordEmbedAuthor `json:"author,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordEmbedAuthor struct {
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url,omitempty"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+type discordRateLimit struct {
+	RetryAfter float64 `json:"retry_after"`
+}
+
+// discordColors follow each source's brand color
+var discordColors = map[string]int{
+	"hackernews":    0xFF6600,
+	"reddit":        0xFF4500,
+	"github":        0x24292F,
+	"gitlab":        0xFC6D26,
+	"gitea":         0x609926,
+	"bitbucket":     0x0052CC,
+	"twitter":       0x1DA1F2,
+	"devto":         0x0A0A0A,
+	"medium":        0x000000,
+	"stackoverflow": 0xF48024,
+	"producthunt":   0xDA552F,
+	"lobsters":      0xAC130D,
+	"pkggodev":      0x00ADD8,
+	"npm":           0xCB3837,
+	"pypi":          0x3775A9,
+	"crates":        0xFFC832,
+	"lemmy":         0x00BC8C,
+	"discourse":     0xFFF9AE,
+	"v2ex":          0x333344,
+	"juejin":        0x1E80FF,
+	"segmentfault":  0x009A61,
+	"youtube":       0xFF0000,
+	"podcast":       0x8A2BE2,
+	"hashnode":      0x2962FF,
+	"substack":      0xFF6719,
+	"ghost":         0x15171A,
+	"telegram":      0x26A5E4,
+	"discord":       0x5865F2,
+	"google":        0x4285F4,
+}
+
+// NewDiscord creates a new Discord webhook notifier
+func NewDiscord(webhookURLs ...string) *Discord {
+	return &Discord{WebhookURLs: webhookURLs}
+}
+
+func (d *Discord) Name() string { return "discord" }
+
+// Send posts an embed for each mention, grouped into as few messages as Discord allows
+func (d *Discord) Send(ctx context.Context, mentions []models.Mention) error {
+	return d.send(ctx, "", mentions)
+}
+
+// SendBatch posts a summary line followed by the mentions' embeds
+func (d *Discord) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	summary := fmt.Sprintf("**%d new mentions**", len(mentions))
+	if len(mentions) == 1 {
+		summary = "**1 new mention**"
+	}
+	return d.send(ctx, summary, mentions)
+}
+
+func (d *Discord) send(ctx context.Context, content string, mentions []models.Mention) error {
+	if len(d.WebhookURLs) == 0 {
+		return fmt.Errorf("discord webhook URL not configured")
+	}
+
+	// Split into messages of at most 10 embeds and 6000 characters of embed text
+	var chunks [][]discordEmbed
+	var chunk []discordEmbed
+	chars := 0
+	for _, m := range mentions {
+		embed := discordMentionEmbed(m)
+		n := embed.length()
+		if len(chunk) == discordMaxEmbeds || (len(chunk) > 0 && chars+n > discordMaxEmbedChars) {
+			chunks = append(chunks, chunk)
+			chunk, chars = nil, 0
+		}
+		chunk = append(chunk, embed)
+		chars += n
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	var lastErr error
+	for i, embeds := range chunks {
+		msg := discordMessage{Embeds: embeds}
+		if i == 0 {
+			msg.Content = content
+		}
+
+		for _, webhookURL := range d.WebhookURLs {
+			if err := d.post(ctx, webhookURL, msg); err != nil {
+				// Log error but continue with other webhooks and mentions
+				fmt.Printf("Failed to send Discord message for %d mentions: %v\n", len(embeds), err)
+				lastErr = err
+			}
+		}
+	}
+
+	return lastErr
+}
+
+// length counts the embed text Discord includes in its per-message limit
+func (e discordEmbed) length() int {
+	n := utf8.RuneCountInString(e.Title) + utf8.RuneCountInString(e.Description)
+	if e.Author != nil {
+		n += utf8.RuneCountInString(e.Author.Name)
+	}
+	if e.Footer != nil {
+		n += utf8.RuneCountInString(e.Footer.Text)
+	}
+	return n
+}
+
+// post sends one message, waiting out rate limits as Discord asks
+func (d *Discord) post(ctx context.Context, webhookURL string, msg discordMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	// wait=true makes Discord validate the message before answering
+	endpoint := webhookURL
+	if strings.Contains(endpoint, "?") {
+		endpoint += "&wait=true"
+	} else {
+		endpoint += "?wait=true"
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < discordMaxRetries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(discordRetryAfter(resp, body)):
+			}
+			continue
+		}
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("discord returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		return nil
+	}
+}
+
+// discordRetryAfter reads the wait from the 429 body, falling back to the Retry-After header
+func discordRetryAfter(resp *http.Response, body []byte) time.Duration {
+	var limit discordRateLimit
+	if err := json.Unmarshal(body, &limit); err == nil && limit.RetryAfter > 0 {
+		return time.Duration(limit.RetryAfter * float64(time.Second))
+	}
+	if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return time.Second
+}
+
+func discordMentionEmbed(m models.Mention) discordEmbed {
+	embed := discordEmbed{
+		Title:       truncateString(m.Title, 256),
+		URL:         m.URL,
+		Description: truncateString(m.Content, 300),
+		Color:       discordColor(m.Source),
+		Footer:      &discordEmbedFooter{Text: formatSourceName(m.Source)},
+	}
+
+	if embed.Title == "" {
+		embed.Title = fmt.Sprintf("New mention on %s", formatSourceName(m.Source))
+	}
+	if m.Keyword != "" {
+		embed.Footer.Text = fmt.Sprintf("%s · keyword: %s", formatSourceName(m.Source), m.Keyword)
+	}
+
+	if m.Author != "" {
+		embed.Author = &discordEmbedAuthor{Name: truncateString(m.Author, 256), IconURL: getSourceIcon(m.Source)}
+	}
+
+	published := m.PublishedAt
+	if published.IsZero() {
+		published = m.DiscoveredAt
+	}
+	if !published.IsZero() {
+		embed.Timestamp = published.UTC().Format(time.RFC3339)
+	}
+
+	return embed
+}
+
+// discordColor returns the source's color, deriving a stable one for unknown sources
+func discordColor(source string) int {
+	if color, ok := discordColors[source]; ok {
+		return color
+	}
+	h := fnv.New32a()
+	h.Write([]byte(source))
+	return int(h.Sum32() & 0xFFFFFF)
+}
@@ -0,0 +1,331 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Email sends mention digests over SMTP with HTML and plain-text parts
+type Email struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+	// TLS is "starttls" (default), "tls" for implicit TLS, or "none" for local relays
+	TLS string
+
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// emailDigest is the data passed to the email templates
+type emailDigest struct {
+	Subject   string
+	Total     int
+	Generated time.Time
+	Groups    []emailGroup
+}
+
+type emailGroup struct {
+	Source   string
+	Mentions []models.Mention
+}
+
+const defaultEmailHTMLTemplate = `<!DOCTYPE html>
+<html>
+<body style="font-family: -apple-system, Helvetica, Arial, sans-serif; color: #222; max-width: 640px;">
+<h2>{{.Subject}}</h2>
+{{range .Groups}}
+<h3 style="border-bottom: 1px solid #ddd; padding-bottom: 4px;">{{.Source}} ({{len .Mentions}})</h3>
+<ul style="padding-left: 18px;">
+{{range .Mentions}}<li style="margin-bottom: 10px;">
+{{if .URL}}<a href="{{.URL}}">{{.Title}}</a>{{else}}{{.Title}}{{end}}<br>
+<small style="color: #666;">{{if .Author}}by {{.Author}}{{end}}{{if and .Author .Keyword}} · {{end}}{{if .Keyword}}keyword: {{.Keyword}}{{end}}</small>
+{{if .Content}}<div style="color: #444;">{{truncate .Content 300}}</div>{{end}}
+</li>
+{{end}}</ul>
+{{end}}
+<p style="color: #999; font-size: 12px;">Sent by mention-monitor at {{.Generated.Format "2006-01-02 15:04 MST"}}</p>
+</body>
+</html>
+`
+
+const defaultEmailTextTemplate = `{{.Subject}}
+{{range .Groups}}
+== {{.Source}} ({{len .Mentions}}) ==
+{{range .Mentions}}
+* {{.Title}}
+{{if .URL}}  {{.URL}}
+{{end}}  {{if .Author}}by {{.Author}}{{end}}{{if and .Author .Keyword}} · {{end}}{{if .Keyword}}keyword: {{.Keyword}}{{end}}
+{{end}}{{end}}
+Sent by mention-monitor at {{.Generated.Format "2006-01-02 15:04 MST"}}
+`
+
+var emailTemplateFuncs = map[string]any{"truncate": truncateString}
+
+// NewEmail creates a new SMTP notifier with the default templates
+func NewEmail(host string, port int, from string, to []string) *Email {
+	return &Email{
+		Host: host,
+		Port: port,
+		From: from,
+		To:   to,
+		TLS:  "starttls",
+		html: htmltemplate.Must(htmltemplate.New("email.html").Funcs(emailTemplateFuncs).Parse(defaultEmailHTMLTemplate)),
+		text: texttemplate.Must(texttemplate.New("email.txt").Funcs(emailTemplateFuncs).Parse(defaultEmailTextTemplate)),
+	}
+}
+
+func (e *Email) Name() string { return "email" }
+
+// LoadTemplates replaces the default templates with the given files; empty paths keep the default
+func (e *Email) LoadTemplates(htmlPath, textPath string) error {
+	if htmlPath != "" {
+		t, err := htmltemplate.New(filepath.Base(htmlPath)).Funcs(emailTemplateFuncs).ParseFiles(htmlPath)
+		if err != nil {
+			return fmt.Errorf("invalid HTML email template: %w", err)
+		}
+		e.html = t
+	}
+	if textPath != "" {
+		t, err := texttemplate.New(filepath.Base(textPath)).Funcs(emailTemplateFuncs).ParseFiles(textPath)
+		if err != nil {
+			return fmt.Errorf("invalid text email template: %w", err)
+		}
+		e.text = t
+	}
+	return nil
+}
+
+// Send sends an email for each mention
+func (e *Email) Send(ctx context.Context, mentions []models.Mention) error {
+	var lastErr error
+	for _, m := range mentions {
+		subject := fmt.Sprintf("New mention on %s: %s", formatSourceName(m.Source), truncateString(m.Title, 80))
+		if err := e.send(ctx, subject, []models.Mention{m}); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send email for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// SendBatch sends a single digest email grouped by source
+func (e *Email) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	subject := fmt.Sprintf("%d new mentions", len(mentions))
+	if len(mentions) == 1 {
+		subject = "1 new mention"
+	}
+	return e.send(ctx, subject, mentions)
+}
+
+func (e *Email) send(ctx context.Context, subject string, mentions []models.Mention) error {
+	if e.Host == "" || e.From == "" || len(e.To) == 0 {
+		return fmt.Errorf("smtp host, sender or recipients not configured")
+	}
+
+	msg, err := e.render(subject, mentions)
+	if err != nil {
+		return err
+	}
+
+	return e.deliver(ctx, msg)
+}
+
+// render builds the multipart/alternative message
+func (e *Email) render(subject string, mentions []models.Mention) ([]byte, error) {
+	digest := emailDigest{
+		Subject:   subject,
+		Total:     len(mentions),
+		Generated: time.Now().UTC(),
+		Groups:    groupBySource(mentions),
+	}
+
+	var text, html bytes.Buffer
+	if err := e.text.Execute(&text, digest); err != nil {
+		return nil, fmt.Errorf("failed to render text email: %w", err)
+	}
+	if err := e.html.Execute(&html, digest); err != nil {
+		return nil, fmt.Errorf("failed to render HTML email: %w", err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		// The last alternative is the preferred one
+		{"text/plain; charset=utf-8", text.Bytes()},
+		{"text/html; charset=utf-8", html.Bytes()},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	headers := [][2]string{
+		{"From", e.From},
+		{"To", strings.Join(e.To, ", ")},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", digest.Generated.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@mention-monitor>", randomID())},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", mw.Boundary())},
+	}
+	for _, h := range headers {
+		fmt.Fprintf(&msg, "%s: %s\r\n", h[0], h[1])
+	}
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// deliver sends a message over SMTP using the configured TLS mode
+func (e *Email) deliver(ctx context.Context, msg []byte) error {
+	port := e.Port
+	if port == 0 {
+		port = 587
+		if e.TLS == "tls" {
+			port = 465
+		}
+	}
+	addr := net.JoinHostPort(e.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: e.Host}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	var conn net.Conn
+	var err error
+	if e.TLS == "tls" {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, e.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if e.TLS == "" || e.TLS == "starttls" {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("starttls failed: %w", err)
+		}
+	}
+
+	if e.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", e.Username, e.Password, e.Host)); err != nil {
+			return fmt.Errorf("smtp auth failed: %w", err)
+		}
+	}
+
+	if err := c.Mail(emailAddress(e.From)); err != nil {
+		return err
+	}
+	for _, to := range e.To {
+		if err := c.Rcpt(emailAddress(to)); err != nil {
+			return fmt.Errorf("recipient %s rejected: %w", to, err)
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// groupBySource groups mentions under their source's display name, largest group first
+func groupBySource(mentions []models.Mention) []emailGroup {
+	index := make(map[string]int)
+	var groups []emailGroup
+	for _, m := range mentions {
+		name := formatSourceName(m.Source)
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, emailGroup{Source: name})
+		}
+		groups[i].Mentions = append(groups[i].Mentions, m)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].Mentions) > len(groups[j].Mentions)
+	})
+	return groups
+}
+
+// emailAddress extracts the bare address from "Name <addr>" forms
+func emailAddress(s string) string {
+	if start := strings.LastIndex(s, "<"); start >= 0 {
+		if end := strings.LastIndex(s, ">"); end > start {
+			return s[start+1 : end]
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+func randomID() string {
+	b := make([]byte, 12)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
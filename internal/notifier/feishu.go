@@ -0,0 +1,140 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Feishu posts mentions as interactive cards to a Feishu/Lark group robot
+type Feishu struct {
+	WebhookURL string
+	// Secret enables signature verification (签名校验) when set
+	Secret string
+}
+
+// feishuMaxCardMentions keeps batched cards well below Feishu's 30 KB card limit
+const feishuMaxCardMentions = 20
+
+type feishuMessage struct {
+	Timestamp string     `json:"timestamp,omitempty"`
+	Sign      string     `json:"sign,omitempty"`
+	MsgType   string     `json:"msg_type"`
+	Card      feishuCard `json:"card"`
+}
+
+type feishuCard struct {
+	Config   map[string]bool `json:"config"`
+	Header   feishuHeader    `json:"header"`
+	Elements []any           `json:"elements"`
+}
+
+type feishuHeader struct {
+	Title    feishuText `json:"title"`
+	Template string     `json:"template"`
+}
+
+type feishuText struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+// NewFeishu creates a new Feishu/Lark group robot notifier
+func NewFeishu(webhookURL, secret string) *Feishu {
+	return &Feishu{WebhookURL: webhookURL, Secret: secret}
+}
+
+func (f *Feishu) Name() string { return "feishu" }
+
+// Send posts a card for each mention
+func (f *Feishu) Send(ctx context.Context, mentions []models.Mention) error {
+	if f.WebhookURL == "" {
+		return fmt.Errorf("feishu webhook URL not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		elements := []any{feishuMarkdown(robotMarkdown(m))}
+		if m.Content != "" {
+			elements = append(elements, feishuMarkdown(robotEscape(truncateString(m.Content, 300))))
+		}
+		if m.URL != "" {
+			elements = append(elements, map[string]any{
+				"tag": "action",
+				"actions": []any{map[string]any{
+					"tag":  "button",
+					"text": feishuText{Tag: "plain_text", Content: "Open"},
+					"url":  m.URL,
+					"type": "primary",
+				}},
+			})
+		}
+
+		title := fmt.Sprintf("New mention on %s", formatSourceName(m.Source))
+		if err := f.post(ctx, title, elements); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send Feishu card for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts cards listing the mentions, 20 per card
+func (f *Feishu) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if f.WebhookURL == "" {
+		return fmt.Errorf("feishu webhook URL not configured")
+	}
+
+	for start := 0; start < len(mentions); start += feishuMaxCardMentions {
+		end := min(start+feishuMaxCardMentions, len(mentions))
+
+		var elements []any
+		for i, m := range mentions[start:end] {
+			if i > 0 {
+				elements = append(elements, map[string]string{"tag": "hr"})
+			}
+			elements = append(elements, feishuMarkdown(robotMarkdown(m)))
+		}
+
+		title := fmt.Sprintf("%d new mentions", len(mentions))
+		if len(mentions) > feishuMaxCardMentions {
+			title = fmt.Sprintf("%d new mentions (%d-%d)", len(mentions), start+1, end)
+		}
+		if err := f.post(ctx, title, elements); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *Feishu) post(ctx context.Context, title string, elements []any) error {
+	msg := feishuMessage{
+		MsgType: "interactive",
+		Card: feishuCard{
+			Config:   map[string]bool{"wide_screen_mode": true},
+			Header:   feishuHeader{Title: feishuText{Tag: "plain_text", Content: title}, Template: "blue"},
+			Elements: elements,
+		},
+	}
+
+	if f.Secret != "" {
+		// Feishu signs an empty message with timestamp + "\n" + secret as the key
+		msg.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		msg.Sign = robotSign(msg.Timestamp+"\n"+f.Secret, "")
+	}
+
+	return postRobot(ctx, "feishu", f.WebhookURL, msg)
+}
+
+func feishuMarkdown(content string) map[string]any {
+	return map[string]any{
+		"tag":  "div",
+		"text": feishuText{Tag: "lark_md", Content: content},
+	}
+}
@@ -0,0 +1,150 @@
+package notifier
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// formatMention returns the title and body of a push notification for one mention
+func formatMention(m models.Mention) (string, string) {
+	// Format: 详细模式
+	// 🔔 New mention on Hacker News
+	// Title: Show HN: lazypg - Terminal UI for PostgreSQL
+	// Author: someone
+
+	title := fmt.Sprintf("New mention on %s", formatSourceName(m.Source))
+	body := fmt.Sprintf("Title: %s", m.Title)
+	if m.Author != "" {
+		body += fmt.Sprintf("\nAuthor: %s", m.Author)
+	}
+	return title, body
+}
+
+// formatBatch returns the title and body of a push notification aggregating mentions
+func formatBatch(mentions []models.Mention) (string, string) {
+	title := fmt.Sprintf("%d new mentions", len(mentions))
+
+	var bodyParts []string
+	for i, m := range mentions {
+		if i >= 5 {
+			bodyParts = append(bodyParts, fmt.Sprintf("... and %d more", len(mentions)-5))
+			break
+		}
+		bodyParts = append(bodyParts, fmt.Sprintf("• [%s] %s", m.Source, truncateString(m.Title, 50)))
+	}
+	return title, strings.Join(bodyParts, "\n")
+}
+
+// sourceNames are the display names of the built-in sources
+var sourceNames = map[string]string{
+	"hackernews":    "Hacker News",
+	"reddit":        "Reddit",
+	"github":        "GitHub",
+	"gitlab":        "GitLab",
+	"gitea":         "Gitea",
+	"bitbucket":     "Bitbucket",
+	"twitter":       "Twitter",
+	"devto":         "Dev.to",
+	"medium":        "Medium",
+	"stackoverflow": "Stack Overflow",
+	"producthunt":   "Product Hunt",
+	"lobsters":      "Lobsters",
+	"pkggodev":      "pkg.go.dev",
+	"npm":           "npm",
+	"pypi":          "PyPI",
+	"crates":        "crates.io",
+	"lemmy":         "Lemmy",
+	"discourse":     "Discourse",
+	"v2ex":          "V2EX",
+	"juejin":        "掘金",
+	"segmentfault":  "思否",
+	"youtube":       "YouTube",
+	"podcast":       "Podcast",
+	"hashnode":      "Hashnode",
+	"substack":      "Substack",
+	"ghost":         "Ghost",
+	"mailinglist":   "Mailing list",
+	"telegram":      "Telegram",
+	"discord":       "Discord",
+	"pagewatch":     "Page watch",
+	"awesome":       "Awesome lists",
+	"google":        "Google",
+	"feed":          "RSS Feed",
+}
+
+// formatSourceName returns a source's display name; custom labels, e.g. from
+// feeds configured with their own source, are shown capitalized
+func formatSourceName(source string) string {
+	if name, ok := sourceNames[source]; ok || source == "" {
+		return name
+	}
+	r, size := utf8.DecodeRuneInString(source)
+	return string(unicode.ToUpper(r)) + source[size:]
+}
+
+func getSourceIcon(source string) string {
+	// Using emoji as icons (Bark supports custom icons via URL too)
+	icons := map[string]string{
+		"hackernews":    "https://news.ycombinator.com/favicon.ico",
+		"reddit":        "https://www.reddit.com/favicon.ico",
+		"github":        "https://github.com/favicon.ico",
+		"gitlab":        "https://gitlab.com/favicon.ico",
+		"gitea":         "https://codeberg.org/favicon.ico",
+		"bitbucket":     "https://bitbucket.org/favicon.ico",
+		"twitter":       "https://twitter.com/favicon.ico",
+		"devto":         "https://dev.to/favicon.ico",
+		"medium":        "https://medium.com/favicon.ico",
+		"stackoverflow": "https://stackoverflow.com/favicon.ico",
+		"producthunt":   "https://www.producthunt.com/favicon.ico",
+		"lobsters":      "https://lobste.rs/favicon.ico",
+		"pkggodev":      "https://pkg.go.dev/favicon.ico",
+		"npm":           "https://www.npmjs.com/favicon.ico",
+		"pypi":          "https://pypi.org/favicon.ico",
+		"crates":        "https://crates.io/favicon.ico",
+		"lemmy":         "https://join-lemmy.org/static/assets/icons/favicon.svg",
+		"discourse":     "https://www.discourse.org/favicon.ico",
+		"v2ex":          "https://www.v2ex.com/static/favicon.ico",
+		"juejin":        "https://juejin.cn/favicon.ico",
+		"segmentfault":  "https://segmentfault.com/favicon.ico",
+		"youtube":       "https://www.youtube.com/favicon.ico",
+		"podcast":       "https://podcastindex.org/favicon.ico",
+		"hashnode":      "https://hashnode.com/favicon.ico",
+		"substack":      "https://substack.com/favicon.ico",
+		"ghost":         "https://ghost.org/favicon.ico",
+		"telegram":      "https://telegram.org/favicon.ico",
+		"discord":       "https://discord.com/favicon.ico",
+		"awesome":       "https://awesome.re/badge.svg",
+		"google":        "https://www.google.com/favicon.ico",
+		"feed":          "https://www.rssboard.org/favicon.ico",
+	}
+	if icon, ok := icons[source]; ok {
+		return icon
+	}
+	// Custom labels come from configured feeds
+	if _, ok := sourceNames[source]; !ok && source != "" {
+		return icons["feed"]
+	}
+	return ""
+}
+
+// getSourceIconPNG returns a PNG favicon for the source, for clients that can't render .ico files
+func getSourceIconPNG(source string) string {
+	u, err := url.Parse(getSourceIcon(source))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return "https://www.google.com/s2/favicons?sz=64&domain=" + u.Host
+}
+
+func truncateString(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
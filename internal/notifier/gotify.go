@@ -0,0 +1,123 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Gotify sends push notifications to a self-hosted Gotify server
+type Gotify struct {
+	ServerURL string
+	// AppToken is the token of the Gotify application messages are sent as
+	AppToken string
+	// Priority of the messages (default: 5)
+	Priority int
+}
+
+type gotifyMessage struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+// NewGotify creates a new Gotify notifier
+func NewGotify(serverURL, appToken string) *Gotify {
+	return &Gotify{
+		ServerURL: strings.TrimSuffix(serverURL, "/"),
+		AppToken:  appToken,
+		Priority:  5,
+	}
+}
+
+func (g *Gotify) Name() string { return "gotify" }
+
+// Send sends a notification for each mention
+func (g *Gotify) Send(ctx context.Context, mentions []models.Mention) error {
+	if g.ServerURL == "" || g.AppToken == "" {
+		return fmt.Errorf("gotify server URL or app token not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		title, body := formatMention(m)
+		body = strings.ReplaceAll(body, "\n", "  \n")
+		if m.URL != "" {
+			body += fmt.Sprintf("\n\n[Open](%s)", m.URL)
+		}
+
+		extras := gotifyMarkdownExtras()
+		if m.URL != "" {
+			extras["client::notification"] = map[string]any{"click": map[string]string{"url": m.URL}}
+		}
+
+		if err := g.post(ctx, gotifyMessage{Title: title, Message: body, Extras: extras}); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send Gotify notification for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch sends a single aggregated notification for multiple mentions
+func (g *Gotify) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if g.ServerURL == "" || g.AppToken == "" {
+		return fmt.Errorf("gotify server URL or app token not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	if len(mentions) == 1 {
+		return g.Send(ctx, mentions)
+	}
+
+	title, body := formatBatch(mentions)
+	body = strings.ReplaceAll(body, "\n", "  \n")
+	return g.post(ctx, gotifyMessage{Title: title, Message: body, Extras: gotifyMarkdownExtras()})
+}
+
+func (g *Gotify) post(ctx context.Context, msg gotifyMessage) error {
+	msg.Priority = g.Priority
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", g.ServerURL+"/message", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", g.AppToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("gotify returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// gotifyMarkdownExtras makes Gotify clients render the message as Markdown,
+// where line breaks need two trailing spaces
+func gotifyMarkdownExtras() map[string]any {
+	return map[string]any{
+		"client::display": map[string]string{"contentType": "text/markdown"},
+	}
+}
@@ -0,0 +1,235 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Matrix posts mentions to Matrix rooms as m.notice events. Only unencrypted
+// rooms are supported.
+type Matrix struct {
+	// HomeserverURL is the client-server API base (e.g., "https://matrix.org")
+	HomeserverURL string
+	AccessToken   string
+	// RoomIDs are internal room IDs (e.g., "!abc:matrix.org"), not aliases
+	RoomIDs []string
+}
+
+// matrixMaxRetries bounds how often a rate-limited event is retried
+const matrixMaxRetries = 3
+
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+type matrixError struct {
+	ErrCode      string `json:"errcode"`
+	Error        string `json:"error"`
+	RetryAfterMS int    `json:"retry_after_ms"`
+}
+
+// NewMatrix creates a new Matrix notifier
+func NewMatrix(homeserverURL, accessToken string, roomIDs ...string) *Matrix {
+	return &Matrix{
+		HomeserverURL: strings.TrimSuffix(homeserverURL, "/"),
+		AccessToken:   accessToken,
+		RoomIDs:       roomIDs,
+	}
+}
+
+func (mx *Matrix) Name() string { return "matrix" }
+
+// Send posts a notice for each mention to every room
+func (mx *Matrix) Send(ctx context.Context, mentions []models.Mention) error {
+	if err := mx.check(); err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		msg := matrixMentionMessage(m)
+		for _, room := range mx.RoomIDs {
+			if err := mx.sendEvent(ctx, room, matrixTxnID(room, m.ID), msg); err != nil {
+				// Log error but continue with other rooms and mentions
+				fmt.Printf("Failed to send Matrix notice for %s to %s: %v\n", m.ID, room, err)
+				lastErr = err
+			}
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts a single notice listing all mentions to every room
+func (mx *Matrix) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if err := mx.check(); err != nil {
+		return err
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	if len(mentions) == 1 {
+		return mx.Send(ctx, mentions)
+	}
+
+	heading := fmt.Sprintf("%d new mentions", len(mentions))
+	plain := []string{heading}
+	formatted := []string{fmt.Sprintf("<strong>%s</strong><ul>", heading)}
+	ids := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		ids = append(ids, m.ID)
+		source := formatSourceName(m.Source)
+		if m.URL == "" {
+			plain = append(plain, fmt.Sprintf("• [%s] %s", source, m.Title))
+			formatted = append(formatted, fmt.Sprintf("<li>[%s] %s</li>", html.EscapeString(source), html.EscapeString(m.Title)))
+			continue
+		}
+		plain = append(plain, fmt.Sprintf("• [%s] %s %s", source, m.Title, m.URL))
+		formatted = append(formatted, fmt.Sprintf("<li>[%s] <a href=\"%s\">%s</a></li>",
+			html.EscapeString(source), html.EscapeString(m.URL), html.EscapeString(m.Title)))
+	}
+	formatted = append(formatted, "</ul>")
+
+	msg := matrixMessage{
+		MsgType:       "m.notice",
+		Body:          strings.Join(plain, "\n"),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: strings.Join(formatted, ""),
+	}
+
+	var lastErr error
+	for _, room := range mx.RoomIDs {
+		if err := mx.sendEvent(ctx, room, matrixTxnID(room, ids...), msg); err != nil {
+			fmt.Printf("Failed to send Matrix digest to %s: %v\n", room, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (mx *Matrix) check() error {
+	if mx.HomeserverURL == "" || mx.AccessToken == "" || len(mx.RoomIDs) == 0 {
+		return fmt.Errorf("matrix homeserver, access token or rooms not configured")
+	}
+	return nil
+}
+
+// sendEvent PUTs a room message. The homeserver deduplicates by transaction ID,
+// so a retried send with the same txnID doesn't post twice.
+func (mx *Matrix) sendEvent(ctx context.Context, roomID, txnID string, msg matrixMessage) error {
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		mx.HomeserverURL, url.PathEscape(roomID), url.PathEscape(txnID))
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "PUT", endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mx.AccessToken)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == 200 {
+			return nil
+		}
+
+		var result matrixError
+		json.Unmarshal(body, &result)
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < matrixMaxRetries {
+			wait := time.Duration(max(result.RetryAfterMS, 1000)) * time.Millisecond
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		if result.ErrCode != "" {
+			return fmt.Errorf("matrix returned status %d: %s %s", resp.StatusCode, result.ErrCode, result.Error)
+		}
+		return fmt.Errorf("matrix returned status %d", resp.StatusCode)
+	}
+}
+
+func matrixMentionMessage(m models.Mention) matrixMessage {
+	source := formatSourceName(m.Source)
+
+	plain := fmt.Sprintf("New mention on %s: %s", source, m.Title)
+	formatted := fmt.Sprintf("<strong>New mention on %s</strong><br>", html.EscapeString(source))
+	if m.URL != "" {
+		plain += "\n" + m.URL
+		formatted += fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(m.URL), html.EscapeString(m.Title))
+	} else {
+		formatted += html.EscapeString(m.Title)
+	}
+
+	var details []string
+	if m.Author != "" {
+		details = append(details, "by "+m.Author)
+	}
+	if m.Keyword != "" {
+		details = append(details, "keyword: "+m.Keyword)
+	}
+	if len(details) > 0 {
+		plain += "\n" + strings.Join(details, " · ")
+		formatted += "<br><em>" + html.EscapeString(strings.Join(details, " · ")) + "</em>"
+	}
+
+	if m.Content != "" {
+		snippet := truncateString(m.Content, 300)
+		plain += "\n\n" + snippet
+		formatted += "<blockquote>" + html.EscapeString(snippet) + "</blockquote>"
+	}
+
+	return matrixMessage{
+		MsgType:       "m.notice",
+		Body:          plain,
+		Format:        "org.matrix.custom.html",
+		FormattedBody: formatted,
+	}
+}
+
+// matrixTxnID derives a transaction ID from the room and mention IDs, so
+// resending the same mentions in a later attempt reuses it
+func matrixTxnID(roomID string, mentionIDs ...string) string {
+	h := sha256.New()
+	h.Write([]byte(roomID))
+	for _, id := range mentionIDs {
+		h.Write([]byte{0})
+		h.Write([]byte(id))
+	}
+	return "mm-" + hex.EncodeToString(h.Sum(nil)[:16])
+}
@@ -0,0 +1,71 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Notifier defines the interface for all notification destinations
+type Notifier interface {
+	Name() string
+	// Send notifies about each mention separately
+	Send(ctx context.Context, mentions []models.Mention) error
+	// SendBatch notifies about all mentions at once
+	SendBatch(ctx context.Context, mentions []models.Mention) error
+}
+
+// DeliveryMode controls when a notifier is sent new mentions
+type DeliveryMode string
+
+const (
+	// Immediate sends each new mention as it is found
+	Immediate DeliveryMode = "immediate"
+	// Batch sends one summary of the mentions found in a run
+	Batch DeliveryMode = "batch"
+	// Hourly, Daily and Weekly queue mentions and send one digest when the
+	// clock hour, day or ISO week (in UTC) has turned over
+	Hourly DeliveryMode = "hourly"
+	Daily  DeliveryMode = "daily"
+	Weekly DeliveryMode = "weekly"
+)
+
+// ParseDeliveryMode parses a delivery mode, returning def when value is empty
+func ParseDeliveryMode(value string, def DeliveryMode) (DeliveryMode, error) {
+	switch mode := DeliveryMode(value); mode {
+	case "":
+		return def, nil
+	case Immediate, Batch, Hourly, Daily, Weekly:
+		return mode, nil
+	default:
+		return def, fmt.Errorf("unknown delivery mode %q", value)
+	}
+}
+
+// Scheduled reports whether mentions are queued for a digest
+func (d DeliveryMode) Scheduled() bool {
+	return d == Hourly || d == Daily || d == Weekly
+}
+
+// Due reports whether the digest window that last was in has closed by now
+func (d DeliveryMode) Due(last, now time.Time) bool {
+	last, now = last.UTC(), now.UTC()
+	switch d {
+	case Hourly:
+		return now.Truncate(time.Hour).After(last)
+	case Daily:
+		return startOfDay(now).After(last)
+	case Weekly:
+		day := startOfDay(now)
+		monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		return monday.After(last)
+	default:
+		return true
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,128 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Ntfy sends push notifications to an ntfy topic (ntfy.sh or self-hosted)
+type Ntfy struct {
+	// ServerURL is the ntfy server URL (default: https://ntfy.sh)
+	ServerURL string
+	Topic     string
+	// Token is an access token for protected topics
+	Token string
+	// Priority is 1 (min) to 5 (max); 0 uses the server default
+	Priority int
+}
+
+type ntfyMessage struct {
+	Topic    string   `json:"topic"`
+	Title    string   `json:"title"`
+	Message  string   `json:"message"`
+	Click    string   `json:"click,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Icon     string   `json:"icon,omitempty"`
+	Priority int      `json:"priority,omitempty"`
+}
+
+// NewNtfy creates a new ntfy notifier
+func NewNtfy(serverURL, topic string) *Ntfy {
+	if serverURL == "" {
+		serverURL = "https://ntfy.sh"
+	}
+	return &Ntfy{
+		ServerURL: strings.TrimSuffix(serverURL, "/"),
+		Topic:     topic,
+	}
+}
+
+func (n *Ntfy) Name() string { return "ntfy" }
+
+// Send sends a notification for each mention
+func (n *Ntfy) Send(ctx context.Context, mentions []models.Mention) error {
+	if n.Topic == "" {
+		return fmt.Errorf("ntfy topic not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		title, body := formatMention(m)
+		msg := ntfyMessage{
+			Title: title,
+			// Tags that aren't emoji short codes are shown as text
+			Tags:    []string{"speech_balloon", m.Source},
+			Message: body,
+			Click:   m.URL,
+			Icon:    getSourceIconPNG(m.Source),
+		}
+		if err := n.publish(ctx, msg); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send ntfy notification for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch sends a single aggregated notification for multiple mentions
+func (n *Ntfy) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if n.Topic == "" {
+		return fmt.Errorf("ntfy topic not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	if len(mentions) == 1 {
+		return n.Send(ctx, mentions)
+	}
+
+	title, body := formatBatch(mentions)
+	return n.publish(ctx, ntfyMessage{Title: title, Message: body, Tags: []string{"speech_balloon"}})
+}
+
+// publish uses the JSON API, which unlike headers carries non-ASCII titles as-is
+func (n *Ntfy) publish(ctx context.Context, msg ntfyMessage) error {
+	msg.Topic = n.Topic
+	msg.Priority = n.Priority
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	serverURL := n.ServerURL
+	if serverURL == "" {
+		serverURL = "https://ntfy.sh"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", serverURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+n.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("ntfy returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
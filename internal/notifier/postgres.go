@@ -51,8 +51,32 @@ func createTable(ctx context.Context, pool *pgxpool.Pool) error {
 			created_at TIMESTAMPTZ DEFAULT NOW()
 		);
 
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS community TEXT;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS score INTEGER;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS comment_count INTEGER;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS thread_id TEXT;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS thread_url TEXT;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS parent_id TEXT;
+		ALTER TABLE mentions ADD COLUMN IF NOT EXISTS parent_content TEXT;
+
 		CREATE INDEX IF NOT EXISTS idx_mentions_discovered_at ON mentions(discovered_at DESC);
 		CREATE INDEX IF NOT EXISTS idx_mentions_url ON mentions(url);
+		CREATE INDEX IF NOT EXISTS idx_mentions_thread_id ON mentions(thread_id);
+		CREATE INDEX IF NOT EXISTS idx_mentions_id_md5 ON mentions(md5(id));
+
+		CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id BIGSERIAL PRIMARY KEY,
+			delivery_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			attempt INTEGER NOT NULL,
+			status_code INTEGER,
+			error TEXT,
+			mention_count INTEGER NOT NULL,
+			duration_ms INTEGER NOT NULL,
+			attempted_at TIMESTAMPTZ NOT NULL
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_delivery_id ON webhook_deliveries(delivery_id);
 	`
 	_, err := pool.Exec(ctx, query)
 	return err
@@ -76,8 +100,8 @@ func (p *Postgres) Send(ctx context.Context, mentions []models.Mention) error {
 
 func (p *Postgres) insertMention(ctx context.Context, m models.Mention) error {
 	query := `
-		INSERT INTO mentions (id, source, type, keyword, title, content, url, author, discovered_at, published_at, status, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 'unread', NOW())
+		INSERT INTO mentions (id, source, type, keyword, title, content, url, author, discovered_at, published_at, community, score, comment_count, thread_id, thread_url, parent_id, parent_content, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, 'unread', NOW())
 		ON CONFLICT (id) DO NOTHING
 	`
 
@@ -92,6 +116,13 @@ func (p *Postgres) insertMention(ctx context.Context, m models.Mention) error {
 		m.Author,
 		m.DiscoveredAt,
 		m.PublishedAt,
+		m.Community,
+		m.Score,
+		m.CommentCount,
+		m.ThreadID,
+		m.ThreadURL,
+		m.ParentID,
+		m.ParentContent,
 	)
 
 	if err != nil {
@@ -101,6 +132,36 @@ func (p *Postgres) insertMention(ctx context.Context, m models.Mention) error {
 	return nil
 }
 
+// UpdateStatus sets the triage status of the mention whose ID has the given MD5
+// hash and reports whether a mention matched
+func (p *Postgres) UpdateStatus(ctx context.Context, idHash, status string) (bool, error) {
+	query := `UPDATE mentions SET status = $1 WHERE md5(id) = $2`
+	tag, err := p.pool.Exec(ctx, query, status, idHash)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// RecordDelivery stores a webhook delivery attempt
+func (p *Postgres) RecordDelivery(ctx context.Context, d WebhookDelivery) error {
+	query := `
+		INSERT INTO webhook_deliveries (delivery_id, url, attempt, status_code, error, mention_count, duration_ms, attempted_at)
+		VALUES ($1, $2, $3, NULLIF($4, 0), NULLIF($5, ''), $6, $7, $8)
+	`
+	_, err := p.pool.Exec(ctx, query,
+		d.DeliveryID,
+		d.URL,
+		d.Attempt,
+		d.StatusCode,
+		d.Error,
+		d.Mentions,
+		d.Duration.Milliseconds(),
+		d.AttemptedAt,
+	)
+	return err
+}
+
 // CheckDuplicate checks if a mention with the given ID already exists
 func (p *Postgres) CheckDuplicate(ctx context.Context, id string) (bool, error) {
 	var exists bool
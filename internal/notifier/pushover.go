@@ -0,0 +1,124 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Pushover sends push notifications via Pushover
+type Pushover struct {
+	AppToken string
+	UserKey  string
+	// Priority is -2 (lowest) to 1 (high); emergency priority 2 needs
+	// retry settings and is not supported
+	Priority int
+	// APIURL is the messages endpoint (default: https://api.pushover.net/1/messages.json)
+	APIURL string
+}
+
+type pushoverResponse struct {
+	Status int      `json:"status"`
+	Errors []string `json:"errors"`
+}
+
+// NewPushover creates a new Pushover notifier
+func NewPushover(appToken, userKey string) *Pushover {
+	return &Pushover{
+		AppToken: appToken,
+		UserKey:  userKey,
+		APIURL:   "https://api.pushover.net/1/messages.json",
+	}
+}
+
+func (p *Pushover) Name() string { return "pushover" }
+
+// Send sends a notification for each mention
+func (p *Pushover) Send(ctx context.Context, mentions []models.Mention) error {
+	if p.AppToken == "" || p.UserKey == "" {
+		return fmt.Errorf("pushover app token or user key not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		title, body := formatMention(m)
+		params := url.Values{}
+		params.Set("title", title)
+		params.Set("message", body)
+		if m.URL != "" {
+			params.Set("url", m.URL)
+			params.Set("url_title", "Open on "+formatSourceName(m.Source))
+		}
+
+		if err := p.post(ctx, params); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send Pushover notification for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch sends a single aggregated notification for multiple mentions
+func (p *Pushover) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if p.AppToken == "" || p.UserKey == "" {
+		return fmt.Errorf("pushover app token or user key not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	if len(mentions) == 1 {
+		return p.Send(ctx, mentions)
+	}
+
+	title, body := formatBatch(mentions)
+	params := url.Values{}
+	params.Set("title", title)
+	params.Set("message", body)
+	return p.post(ctx, params)
+}
+
+func (p *Pushover) post(ctx context.Context, params url.Values) error {
+	params.Set("token", p.AppToken)
+	params.Set("user", p.UserKey)
+	params.Set("priority", strconv.Itoa(max(min(p.Priority, 1), -2)))
+	// Pushover rejects titles over 250 and messages over 1024 characters
+	params.Set("title", truncateString(params.Get("title"), 250))
+	params.Set("message", truncateString(params.Get("message"), 1024))
+
+	apiURL := p.APIURL
+	if apiURL == "" {
+		apiURL = "https://api.pushover.net/1/messages.json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		var result pushoverResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err == nil && len(result.Errors) > 0 {
+			return fmt.Errorf("pushover returned status %d: %s", resp.StatusCode, strings.Join(result.Errors, "; "))
+		}
+		return fmt.Errorf("pushover returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// robotResponse covers the replies of Feishu ("code"/"msg") and
+// DingTalk/WeCom ("errcode"/"errmsg") group robots
+type robotResponse struct {
+	Code    int    `json:"code"`
+	Msg     string `json:"msg"`
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// postRobot posts a JSON payload to a group robot webhook; the robots answer
+// 200 with an error code in the body when a message is rejected
+func postRobot(ctx context.Context, name, webhookURL string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("%s returned status %d", name, resp.StatusCode)
+	}
+
+	var result robotResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.Code != 0 {
+		return fmt.Errorf("%s error %d: %s", name, result.Code, result.Msg)
+	}
+	if result.ErrCode != 0 {
+		return fmt.Errorf("%s error %d: %s", name, result.ErrCode, result.ErrMsg)
+	}
+	return nil
+}
+
+// robotSign returns base64(HMAC-SHA256(key, message)) as used by robot signatures
+func robotSign(key, message string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(message))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// robotMarkdown renders a mention as Markdown for group robots
+func robotMarkdown(m models.Mention) string {
+	text := fmt.Sprintf("**[%s](%s)**", robotEscape(m.Title), m.URL)
+	if m.URL == "" {
+		text = fmt.Sprintf("**%s**", robotEscape(m.Title))
+	}
+
+	details := formatSourceName(m.Source)
+	if m.Author != "" {
+		details += " · " + robotEscape(m.Author)
+	}
+	if m.Keyword != "" {
+		details += " · " + robotEscape(m.Keyword)
+	}
+	return text + "\n" + details
+}
+
+// robotChunks splits Markdown entries into messages of at most maxBytes
+func robotChunks(entries []string, maxBytes int) []string {
+	var chunks []string
+	var current strings.Builder
+	for _, entry := range entries {
+		if current.Len() > 0 && current.Len()+len(entry)+2 > maxBytes {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteString("\n\n")
+		}
+		current.WriteString(entry)
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+	return chunks
+}
+
+// robotEscape keeps titles from breaking the surrounding Markdown link syntax
+func robotEscape(s string) string {
+	return strings.NewReplacer("[", "【", "]", "】", "\n", " ").Replace(s)
+}
@@ -0,0 +1,345 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Slack posts mentions to a Slack channel via an incoming webhook or a bot token
+type Slack struct {
+	// WebhookURL is an incoming webhook URL; it cannot post thread replies
+	WebhookURL string
+	// BotToken is a bot token with chat:write, used together with Channel
+	BotToken string
+	Channel  string
+	// APIURL is the Slack Web API base URL (default: https://slack.com/api)
+	APIURL string
+}
+
+// slackMaxRetries bounds how often a rate-limited message is retried
+const slackMaxRetries = 3
+
+// slackMaxSectionChars is Slack's limit on a section block's text
+const slackMaxSectionChars = 3000
+
+// slackMaxThreadReplies caps the mentions posted in a digest's thread
+const slackMaxThreadReplies = 20
+
+// slackPostInterval paces thread replies to Slack's one message per second per channel
+const slackPostInterval = time.Second
+
+type slackMessage struct {
+	Channel  string       `json:"channel,omitempty"`
+	Text     string       `json:"text"`
+	Blocks   []slackBlock `json:"blocks,omitempty"`
+	ThreadTS string       `json:"thread_ts,omitempty"`
+	// UnfurlLinks is off so link previews don't drown the blocks
+	UnfurlLinks bool `json:"unfurl_links"`
+}
+
+type slackBlock struct {
+	Type     string         `json:"type"`
+	Text     *slackText     `json:"text,omitempty"`
+	Elements []slackElement `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackElement struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
+}
+
+type slackResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	TS    string `json:"ts"`
+}
+
+// NewSlackWebhook creates a Slack notifier that posts to an incoming webhook
+func NewSlackWebhook(webhookURL string) *Slack {
+	return &Slack{WebhookURL: webhookURL}
+}
+
+// NewSlackBot creates a Slack notifier that posts as a bot to a channel
+func NewSlackBot(botToken, channel string) *Slack {
+	return &Slack{
+		BotToken: botToken,
+		Channel:  channel,
+		APIURL:   "https://slack.com/api",
+	}
+}
+
+func (s *Slack) Name() string { return "slack" }
+
+// Send posts a message for each mention
+func (s *Slack) Send(ctx context.Context, mentions []models.Mention) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+
+	var lastErr error
+	for i, m := range mentions {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(slackPostInterval):
+			}
+		}
+		if _, err := s.post(ctx, slackMentionMessage(m, "")); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send Slack message for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts a summary message; with a bot token up to 20 mentions
+// follow as replies in the summary's thread, with a webhook they are listed inline
+func (s *Slack) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	summary := slackSummaryMessage(mentions, s.BotToken == "")
+	ts, err := s.post(ctx, summary)
+	if err != nil {
+		return err
+	}
+
+	if s.BotToken == "" {
+		return nil
+	}
+
+	// Replies are paced, so cap them to keep a large digest from using up
+	// the run's time
+	var replies []slackMessage
+	for i, m := range mentions {
+		if i == slackMaxThreadReplies {
+			replies = append(replies, slackMessage{Text: fmt.Sprintf("… and %d more", len(mentions)-i), ThreadTS: ts})
+			break
+		}
+		replies = append(replies, slackMentionMessage(m, ts))
+	}
+
+	var lastErr error
+	for _, msg := range replies {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(slackPostInterval):
+		}
+		if _, err := s.post(ctx, msg); err != nil {
+			fmt.Printf("Failed to send Slack thread reply: %v\n", err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (s *Slack) check() error {
+	if s.BotToken != "" && s.Channel == "" {
+		return fmt.Errorf("slack channel not configured")
+	}
+	if s.BotToken == "" && s.WebhookURL == "" {
+		return fmt.Errorf("slack webhook URL or bot token not configured")
+	}
+	return nil
+}
+
+// post sends a message and returns its timestamp (empty for webhooks),
+// waiting out rate limits as Slack asks
+func (s *Slack) post(ctx context.Context, msg slackMessage) (string, error) {
+	endpoint := s.WebhookURL
+	if s.BotToken != "" {
+		msg.Channel = s.Channel
+		apiURL := s.APIURL
+		if apiURL == "" {
+			apiURL = "https://slack.com/api"
+		}
+		endpoint = strings.TrimSuffix(apiURL, "/") + "/chat.postMessage"
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		if s.BotToken != "" {
+			req.Header.Set("Authorization", "Bearer "+s.BotToken)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if err != nil {
+			return "", err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < slackMaxRetries {
+			wait, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+			if err != nil || wait <= 0 {
+				wait = 1
+			}
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Duration(wait) * time.Second):
+			}
+			continue
+		}
+
+		if resp.StatusCode != 200 {
+			return "", fmt.Errorf("slack returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+
+		// Webhooks answer with plain "ok"
+		if s.BotToken == "" {
+			return "", nil
+		}
+
+		var result slackResponse
+		if err := json.Unmarshal(body, &result); err != nil {
+			return "", err
+		}
+		if !result.OK {
+			return "", fmt.Errorf("slack error: %s", result.Error)
+		}
+		return result.TS, nil
+	}
+}
+
+// slackMentionMessage renders one mention: linked title, snippet and a context
+// line with source icon, author and keyword
+func slackMentionMessage(m models.Mention, threadTS string) slackMessage {
+	title := slackEscape(m.Title)
+	if m.URL != "" {
+		title = fmt.Sprintf("<%s|%s>", m.URL, title)
+	}
+
+	text := fmt.Sprintf("*%s*", title)
+	if m.Content != "" {
+		text += "\n" + slackEscape(truncateString(m.Content, 300))
+	}
+
+	var elements []slackElement
+	if icon := getSourceIconPNG(m.Source); icon != "" {
+		elements = append(elements, slackElement{Type: "image", ImageURL: icon, AltText: formatSourceName(m.Source)})
+	}
+	details := formatSourceName(m.Source)
+	if m.Author != "" {
+		details += " · " + slackEscape(m.Author)
+	}
+	if m.Keyword != "" {
+		details += fmt.Sprintf(" · keyword *%s*", slackEscape(m.Keyword))
+	}
+	elements = append(elements, slackElement{Type: "mrkdwn", Text: details})
+
+	return slackMessage{
+		Text: fmt.Sprintf("New mention on %s: %s", formatSourceName(m.Source), m.Title),
+		Blocks: []slackBlock{
+			{Type: "section", Text: &slackText{Type: "mrkdwn", Text: text}},
+			{Type: "context", Elements: elements},
+		},
+		ThreadTS: threadTS,
+	}
+}
+
+// slackSummaryMessage renders the counts per source and, when the mentions
+// can't follow in a thread, a linked list of them
+func slackSummaryMessage(mentions []models.Mention, inline bool) slackMessage {
+	counts := make(map[string]int)
+	for _, m := range mentions {
+		counts[formatSourceName(m.Source)]++
+	}
+	sources := make([]string, 0, len(counts))
+	for source := range counts {
+		sources = append(sources, source)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		if counts[sources[i]] != counts[sources[j]] {
+			return counts[sources[i]] > counts[sources[j]]
+		}
+		return sources[i] < sources[j]
+	})
+
+	var lines []string
+	for _, source := range sources {
+		lines = append(lines, fmt.Sprintf("• %s: %d", source, counts[source]))
+	}
+
+	heading := fmt.Sprintf("%d new mentions", len(mentions))
+	if len(mentions) == 1 {
+		heading = "1 new mention"
+	}
+
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: heading}},
+		{Type: "section", Text: &slackText{Type: "mrkdwn", Text: strings.Join(lines, "\n")}},
+	}
+
+	if inline {
+		// List whole entries only, so links are never cut, keeping room for
+		// the "… and N more" line
+		var items []string
+		chars := 0
+		for i, m := range mentions {
+			title := slackEscape(truncateString(m.Title, 80))
+			if m.URL != "" {
+				title = fmt.Sprintf("<%s|%s>", m.URL, title)
+			}
+			item := fmt.Sprintf("• [%s] %s", formatSourceName(m.Source), title)
+			n := utf8.RuneCountInString(item) + 1
+			if i >= 20 || chars+n > slackMaxSectionChars-32 {
+				items = append(items, fmt.Sprintf("… and %d more", len(mentions)-i))
+				break
+			}
+			items = append(items, item)
+			chars += n
+		}
+		blocks = append(blocks,
+			slackBlock{Type: "divider"},
+			slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: strings.Join(items, "\n")}},
+		)
+	}
+
+	return slackMessage{Text: heading, Blocks: blocks}
+}
+
+// slackEscape escapes the characters Slack treats as control sequences
+func slackEscape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,301 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// Telegram sends mentions to a chat via the Bot API, with buttons to triage them
+type Telegram struct {
+	BotToken string
+	ChatID   string
+	// APIURL is the Bot API base URL (default: https://api.telegram.org)
+	APIURL string
+}
+
+// TriageStatuses maps the statuses the inline buttons set to their labels
+var TriageStatuses = map[string]string{
+	"read":        "Mark read",
+	"ignored":     "Ignore",
+	"needs_reply": "Needs reply",
+}
+
+// telegramMaxRetries bounds how often a rate-limited request is retried
+const telegramMaxRetries = 3
+
+type telegramResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+	Parameters  struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters"`
+}
+
+type telegramButton struct {
+	Text         string `json:"text"`
+	CallbackData string `json:"callback_data"`
+}
+
+type telegramCallbackUpdate struct {
+	UpdateID      int `json:"update_id"`
+	CallbackQuery *struct {
+		ID   string `json:"id"`
+		Data string `json:"data"`
+		From struct {
+			Username string `json:"username"`
+		} `json:"from"`
+	} `json:"callback_query"`
+}
+
+// CallbackHandler applies a triage status to the mention whose ID hashes to idHash
+type CallbackHandler func(ctx context.Context, idHash, status string) error
+
+// NewTelegram creates a new Telegram notifier
+func NewTelegram(botToken, chatID string) *Telegram {
+	return &Telegram{
+		BotToken: botToken,
+		ChatID:   chatID,
+		APIURL:   "https://api.telegram.org",
+	}
+}
+
+func (t *Telegram) Name() string { return "telegram" }
+
+// Send sends a message with triage buttons for each mention
+func (t *Telegram) Send(ctx context.Context, mentions []models.Mention) error {
+	if t.BotToken == "" || t.ChatID == "" {
+		return fmt.Errorf("telegram bot token or chat ID not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		if err := t.sendOne(ctx, m); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send Telegram message for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (t *Telegram) sendOne(ctx context.Context, m models.Mention) error {
+	text := fmt.Sprintf("*New mention on %s*\n", telegramEscape(formatSourceName(m.Source)))
+	if m.URL != "" {
+		text += fmt.Sprintf("[%s](%s)", telegramEscape(m.Title), telegramEscapeURL(m.URL))
+	} else {
+		text += telegramEscape(m.Title)
+	}
+
+	var details []string
+	if m.Author != "" {
+		details = append(details, "by "+telegramEscape(m.Author))
+	}
+	if m.Keyword != "" {
+		details = append(details, fmt.Sprintf("keyword `%s`", telegramEscapeCode(m.Keyword)))
+	}
+	if len(details) > 0 {
+		text += "\n" + strings.Join(details, " · ")
+	}
+	if m.Content != "" {
+		text += "\n\n" + telegramEscape(truncateString(m.Content, 300))
+	}
+
+	hash := MentionHash(m.ID)
+	var row []telegramButton
+	for _, status := range []string{"read", "ignored", "needs_reply"} {
+		row = append(row, telegramButton{Text: TriageStatuses[status], CallbackData: status + ":" + hash})
+	}
+
+	_, err := t.call(ctx, "sendMessage", map[string]any{
+		"chat_id":                  t.ChatID,
+		"text":                     text,
+		"parse_mode":               "MarkdownV2",
+		"disable_web_page_preview": true,
+		"reply_markup":             map[string]any{"inline_keyboard": [][]telegramButton{row}},
+	})
+	return err
+}
+
+// SendBatch sends a single message listing all mentions
+func (t *Telegram) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if t.BotToken == "" || t.ChatID == "" {
+		return fmt.Errorf("telegram bot token or chat ID not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	if len(mentions) == 1 {
+		return t.sendOne(ctx, mentions[0])
+	}
+
+	lines := []string{fmt.Sprintf("*%d new mentions*", len(mentions))}
+	for i, m := range mentions {
+		if i >= 20 {
+			lines = append(lines, telegramEscape(fmt.Sprintf("... and %d more", len(mentions)-20)))
+			break
+		}
+		title := telegramEscape(truncateString(m.Title, 80))
+		if m.URL != "" {
+			title = fmt.Sprintf("[%s](%s)", title, telegramEscapeURL(m.URL))
+		}
+		lines = append(lines, fmt.Sprintf("• \\[%s\\] %s", telegramEscape(formatSourceName(m.Source)), title))
+	}
+
+	_, err := t.call(ctx, "sendMessage", map[string]any{
+		"chat_id":                  t.ChatID,
+		"text":                     strings.Join(lines, "\n"),
+		"parse_mode":               "MarkdownV2",
+		"disable_web_page_preview": true,
+	})
+	return err
+}
+
+// ProcessCallbacks applies pending button presses with handler and returns how
+// many were handled. With a zero timeout it returns immediately; otherwise it
+// long-polls for up to timeout.
+func (t *Telegram) ProcessCallbacks(ctx context.Context, timeout time.Duration, handler CallbackHandler) (int, error) {
+	if t.BotToken == "" {
+		return 0, fmt.Errorf("telegram bot token not configured")
+	}
+
+	handled := 0
+	offset := 0
+	for {
+		result, err := t.call(ctx, "getUpdates", map[string]any{
+			"offset":          offset,
+			"timeout":         int(timeout.Seconds()),
+			"allowed_updates": []string{"callback_query"},
+		})
+		if err != nil {
+			return handled, err
+		}
+
+		var updates []telegramCallbackUpdate
+		if err := json.Unmarshal(result, &updates); err != nil {
+			return handled, err
+		}
+		if len(updates) == 0 {
+			return handled, nil
+		}
+
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			if u.CallbackQuery == nil {
+				continue
+			}
+
+			answer := "Unknown action"
+			status, hash, ok := strings.Cut(u.CallbackQuery.Data, ":")
+			if _, known := TriageStatuses[status]; ok && known {
+				if err := handler(ctx, hash, status); err != nil {
+					fmt.Printf("Failed to apply Telegram action %s: %v\n", u.CallbackQuery.Data, err)
+					answer = "Failed to update mention"
+				} else {
+					handled++
+					answer = "Status: " + strings.ReplaceAll(status, "_", " ")
+				}
+			}
+
+			if _, err := t.call(ctx, "answerCallbackQuery", map[string]any{
+				"callback_query_id": u.CallbackQuery.ID,
+				"text":              answer,
+			}); err != nil {
+				fmt.Printf("Failed to answer Telegram callback: %v\n", err)
+			}
+		}
+
+		// The next request with the new offset confirms these updates
+		timeout = 0
+	}
+}
+
+// call invokes a Bot API method, waiting out rate limits as Telegram asks
+func (t *Telegram) call(ctx context.Context, method string, params map[string]any) (json.RawMessage, error) {
+	apiURL := t.APIURL
+	if apiURL == "" {
+		apiURL = "https://api.telegram.org"
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/%s", strings.TrimSuffix(apiURL, "/"), t.BotToken, method)
+
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result telegramResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("telegram %s returned status %d", method, resp.StatusCode)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < telegramMaxRetries {
+			wait := time.Duration(max(result.Parameters.RetryAfter, 1)) * time.Second
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(wait):
+			}
+			continue
+		}
+
+		if !result.OK {
+			return nil, fmt.Errorf("telegram %s error: %s", method, result.Description)
+		}
+		return result.Result, nil
+	}
+}
+
+// MentionHash shortens a mention ID to fit Telegram's 64-byte callback data;
+// it matches PostgreSQL's md5(id)
+func MentionHash(id string) string {
+	sum := md5.Sum([]byte(id))
+	return hex.EncodeToString(sum[:])
+}
+
+// telegramEscape escapes text for MarkdownV2
+func telegramEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune("_*[]()~`>#+-=|{}.!\\", r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// telegramEscapeURL escapes the URL part of a MarkdownV2 link
+func telegramEscapeURL(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `)`, `\)`).Replace(s)
+}
+
+// telegramEscapeCode escapes text inside MarkdownV2 inline code
+func telegramEscapeCode(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s)
+}
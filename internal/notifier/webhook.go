@@ -0,0 +1,251 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// WebhookSchemaVersion is bumped whenever the payload changes incompatibly
+const WebhookSchemaVersion = 1
+
+// Headers set on every webhook request
+const (
+	WebhookSignatureHeader = "X-Mention-Monitor-Signature"
+	WebhookTimestampHeader = "X-Mention-Monitor-Timestamp"
+	WebhookDeliveryHeader  = "X-Mention-Monitor-Delivery"
+)
+
+// webhookMaxRetries bounds how often a failed delivery is retried
+const webhookMaxRetries = 4
+
+// Webhook posts signed JSON payloads to arbitrary HTTP endpoints
+type Webhook struct {
+	URLs []string
+	// Secret signs payloads with HMAC-SHA256; requests are unsigned when empty
+	Secret string
+	// Run is copied into every payload
+	Run WebhookRun
+	// Recorder, if set, is called after every delivery attempt
+	Recorder func(ctx context.Context, d WebhookDelivery) error
+	// Backoff is the wait before the first retry; it doubles on each retry
+	Backoff time.Duration
+}
+
+// WebhookRun describes the monitor run that produced the mentions
+type WebhookRun struct {
+	ID        string    `json:"id"`
+	StartedAt time.Time `json:"started_at"`
+	Keywords  []string  `json:"keywords"`
+	Collected int       `json:"collected"`
+}
+
+// WebhookPayload is the JSON body posted to webhook URLs
+type WebhookPayload struct {
+	Version    int              `json:"version"`
+	Event      string           `json:"event"`
+	DeliveryID string           `json:"delivery_id"`
+	SentAt     time.Time        `json:"sent_at"`
+	Run        WebhookRun       `json:"run"`
+	Mentions   []models.Mention `json:"mentions"`
+}
+
+// WebhookDelivery records one attempt to deliver a payload
+type WebhookDelivery struct {
+	DeliveryID  string
+	URL         string
+	Attempt     int
+	StatusCode  int
+	Error       string
+	Mentions    int
+	AttemptedAt time.Time
+	Duration    time.Duration
+}
+
+// NewWebhook creates a new generic webhook notifier
+func NewWebhook(secret string, urls ...string) *Webhook {
+	return &Webhook{URLs: urls, Secret: secret, Backoff: time.Second}
+}
+
+func (w *Webhook) Name() string { return "webhook" }
+
+// Send posts a payload for each mention to every URL
+func (w *Webhook) Send(ctx context.Context, mentions []models.Mention) error {
+	if len(w.URLs) == 0 {
+		return fmt.Errorf("webhook URLs not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		for _, u := range w.URLs {
+			if err := w.deliver(ctx, u, []models.Mention{m}); err != nil {
+				// Log error but continue with other URLs and mentions
+				fmt.Printf("Failed to deliver webhook for %s to %s: %v\n", m.ID, u, err)
+				lastErr = err
+			}
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts a single payload with all mentions to every URL
+func (w *Webhook) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if len(w.URLs) == 0 {
+		return fmt.Errorf("webhook URLs not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	var lastErr error
+	for _, u := range w.URLs {
+		if err := w.deliver(ctx, u, mentions); err != nil {
+			fmt.Printf("Failed to deliver webhook to %s: %v\n", u, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// deliver posts one payload, retrying network errors, 429 and 5xx responses
+// with exponential backoff. Every attempt keeps the delivery ID so receivers
+// can deduplicate, but is signed with a fresh timestamp.
+func (w *Webhook) deliver(ctx context.Context, endpoint string, mentions []models.Mention) error {
+	payload := WebhookPayload{
+		Version:    WebhookSchemaVersion,
+		Event:      "mentions.new",
+		DeliveryID: newDeliveryID(),
+		SentAt:     time.Now().UTC(),
+		Run:        w.Run,
+		Mentions:   mentions,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	backoff := w.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		status, retryAfter, err := w.post(ctx, endpoint, payload.DeliveryID, body)
+
+		delivery := WebhookDelivery{
+			DeliveryID:  payload.DeliveryID,
+			URL:         endpoint,
+			Attempt:     attempt,
+			StatusCode:  status,
+			Mentions:    len(mentions),
+			AttemptedAt: start.UTC(),
+			Duration:    time.Since(start),
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		if w.Recorder != nil {
+			if rerr := w.Recorder(ctx, delivery); rerr != nil {
+				fmt.Printf("Failed to record webhook delivery %s: %v\n", payload.DeliveryID, rerr)
+			}
+		}
+
+		if err == nil {
+			return nil
+		}
+
+		retryable := status == 0 || status == http.StatusTooManyRequests || status >= 500
+		if !retryable || attempt > webhookMaxRetries || ctx.Err() != nil {
+			return err
+		}
+
+		wait := max(backoff, retryAfter)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends a single signed request and returns the status code (zero on
+// network errors) and any Retry-After delay the endpoint asked for
+func (w *Webhook) post(ctx context.Context, endpoint, deliveryID string, body []byte) (int, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mention-monitor")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookDeliveryHeader, deliveryID)
+	if w.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, WebhookSignature(w.Secret, timestamp, body))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.StatusCode, 0, nil
+	}
+
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+	return resp.StatusCode, retryAfter, fmt.Errorf("webhook returned status %d", resp.StatusCode)
+}
+
+// WebhookSignature returns the signature header value for a request body:
+// "sha256=" followed by the hex HMAC-SHA256 of timestamp + "." + body
+func WebhookSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks a received request's signature and rejects
+// timestamps further than tolerance from now, so captured requests can't be
+// replayed later
+func VerifyWebhookSignature(secret, timestamp, signature string, body []byte, tolerance time.Duration) error {
+	sent, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid webhook timestamp %q", timestamp)
+	}
+	if age := time.Since(time.Unix(sent, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("webhook timestamp outside tolerance")
+	}
+	if !hmac.Equal([]byte(signature), []byte(WebhookSignature(secret, timestamp, body))) {
+		return fmt.Errorf("webhook signature mismatch")
+	}
+	return nil
+}
+
+func newDeliveryID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/rebelice/mention-monitor/internal/models"
+)
+
+// WeCom posts mentions as Markdown to a WeCom (企业微信) group robot
+type WeCom struct {
+	// WebhookURL includes the robot's key
+	WebhookURL string
+}
+
+// weComMaxBytes is WeCom's limit for Markdown content
+const weComMaxBytes = 4096
+
+type weComMessage struct {
+	MsgType  string        `json:"msgtype"`
+	Markdown weComMarkdown `json:"markdown"`
+}
+
+type weComMarkdown struct {
+	Content string `json:"content"`
+}
+
+// NewWeCom creates a new WeCom group robot notifier
+func NewWeCom(webhookURL string) *WeCom {
+	return &WeCom{WebhookURL: webhookURL}
+}
+
+func (w *WeCom) Name() string { return "wecom" }
+
+// Send posts a message for each mention
+func (w *WeCom) Send(ctx context.Context, mentions []models.Mention) error {
+	if w.WebhookURL == "" {
+		return fmt.Errorf("wecom webhook URL not configured")
+	}
+
+	var lastErr error
+	for _, m := range mentions {
+		content := fmt.Sprintf("New mention on <font color=\"info\">%s</font>\n%s", formatSourceName(m.Source), robotMarkdown(m))
+		if m.Content != "" {
+			content += "\n> " + robotEscape(truncateString(m.Content, 300))
+		}
+
+		if err := w.post(ctx, content); err != nil {
+			// Log error but continue with other mentions
+			fmt.Printf("Failed to send WeCom message for %s: %v\n", m.ID, err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// SendBatch posts the mentions as a list, split to fit WeCom's size limit
+func (w *WeCom) SendBatch(ctx context.Context, mentions []models.Mention) error {
+	if w.WebhookURL == "" {
+		return fmt.Errorf("wecom webhook URL not configured")
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+
+	heading := fmt.Sprintf("**%d new mentions**", len(mentions))
+	var entries []string
+	for _, m := range mentions {
+		entries = append(entries, robotMarkdown(m))
+	}
+
+	for _, chunk := range robotChunks(entries, weComMaxBytes-len(heading)-2) {
+		if err := w.post(ctx, heading+"\n\n"+chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *WeCom) post(ctx context.Context, content string) error {
+	// A single mention with a very long title can still exceed the limit
+	for len(content) > weComMaxBytes {
+		_, size := utf8.DecodeLastRuneInString(content)
+		content = content[:len(content)-size]
+	}
+
+	msg := weComMessage{
+		MsgType:  "markdown",
+		Markdown: weComMarkdown{Content: content},
+	}
+	return postRobot(ctx, "wecom", w.WebhookURL, msg)
+}
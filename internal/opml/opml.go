@@ -0,0 +1,165 @@
+package opml
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+)
+
+// Document is an OPML 2.0 document
+type Document struct {
+	XMLName xml.Name  `xml:"opml"`
+	Version string    `xml:"version,attr"`
+	Head    Head      `xml:"head"`
+	Body    []Outline `xml:"body>outline"`
+}
+
+// Head holds OPML document metadata
+type Head struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+// Outline is a feed subscription or a folder of subscriptions
+type Outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XMLURL   string    `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string    `xml:"htmlUrl,attr,omitempty"`
+	Category string    `xml:"category,attr,omitempty"`
+	Outlines []Outline `xml:"outline"`
+}
+
+// Subscription is a flattened feed outline with its categories
+type Subscription struct {
+	Title   string
+	XMLURL  string
+	HTMLURL string
+	// Categories come from enclosing folders and the category attribute
+	Categories []string
+}
+
+// Parse reads an OPML document
+func Parse(r io.Reader) (*Document, error) {
+	var doc Document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Subscriptions flattens the outline tree into feed subscriptions
+func (d *Document) Subscriptions() []Subscription {
+	var subs []Subscription
+	for _, o := range d.Body {
+		subs = appendSubscriptions(subs, o, nil)
+	}
+	return subs
+}
+
+func appendSubscriptions(subs []Subscription, o Outline, folders []string) []Subscription {
+	if o.XMLURL == "" {
+		// Folder: its text becomes a category of everything inside it
+		folder := o.Title
+		if folder == "" {
+			folder = o.Text
+		}
+		inner := folders
+		if folder != "" {
+			inner = append(append([]string{}, folders...), folder)
+		}
+		for _, child := range o.Outlines {
+			subs = appendSubscriptions(subs, child, inner)
+		}
+		return subs
+	}
+
+	title := o.Title
+	if title == "" {
+		title = o.Text
+	}
+
+	categories := append([]string{}, folders...)
+	for _, c := range strings.Split(o.Category, ",") {
+		// Categories may be slash-delimited paths like "/Go/Blogs"
+		c = strings.Trim(strings.TrimSpace(c), "/")
+		if c != "" && !contains(categories, c) {
+			categories = append(categories, c)
+		}
+	}
+
+	return append(subs, Subscription{
+		Title:      title,
+		XMLURL:     o.XMLURL,
+		HTMLURL:    o.HTMLURL,
+		Categories: categories,
+	})
+}
+
+// New builds a document grouping subscriptions into folders by their first category
+func New(title string, subs []Subscription) *Document {
+	doc := &Document{
+		Version: "2.0",
+		Head: Head{
+			Title:       title,
+			DateCreated: time.Now().UTC().Format(time.RFC1123Z),
+		},
+	}
+
+	folders := make(map[string]int)
+	for _, s := range subs {
+		text := s.Title
+		if text == "" {
+			text = s.XMLURL
+		}
+		o := Outline{
+			Text:     text,
+			Title:    s.Title,
+			Type:     "rss",
+			XMLURL:   s.XMLURL,
+			HTMLURL:  s.HTMLURL,
+			Category: strings.Join(s.Categories, ","),
+		}
+
+		if len(s.Categories) == 0 {
+			doc.Body = append(doc.Body, o)
+			continue
+		}
+
+		folder := s.Categories[0]
+		i, ok := folders[folder]
+		if !ok {
+			doc.Body = append(doc.Body, Outline{Text: folder, Title: folder})
+			i = len(doc.Body) - 1
+			folders[folder] = i
+		}
+		doc.Body[i].Outlines = append(doc.Body[i].Outlines, o)
+	}
+
+	return doc
+}
+
+// Write encodes the document as indented XML
+func (d *Document) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(d); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func contains(items []string, s string) bool {
+	for _, item := range items {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}